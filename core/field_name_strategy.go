@@ -0,0 +1,382 @@
+package core
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+/*
+FieldNameStrategy resolves the name of a struct field in a path.JSONPath e.g., `first_name` in `$.user.first_name` for the field
+FirstName tagged with `json:"first_name"`.
+
+It allows the same path to address a struct and its JSON form the same way. Only exported fields can be resolved.
+
+The fields of embedded structs are promoted following Go's rules e.g., `$.ID` resolves to `Order.BaseEntity.ID`. A field at a shallower depth hides
+fields with the same name at a deeper depth and fields with the same name at the same depth are ambiguous and cannot be resolved. An embedded struct
+is not promoted if it is tagged with a name when the strategy uses struct tags e.g., `json:"base"` with FieldNameJSONTag.
+
+The fields of each struct type are looked up once and cached. A nil FieldNameStrategy resolves fields by their Go name like FieldNameGo.
+
+Use one of the predefined strategies or create one with NewFieldNameStrategy.
+*/
+type FieldNameStrategy struct {
+	// Returns the name of field in a path and false if field cannot be addressed by a path.
+	fieldName func(field reflect.StructField) (string, bool)
+
+	// Key of the struct tag used by fieldName if any. Embedded structs tagged with a name are not promoted.
+	tagKey string
+
+	// If true, names are matched ignoring case.
+	caseInsensitive bool
+
+	// Cache of *structFields for each reflect.Type.
+	structFields sync.Map
+}
+
+/*
+StructField is an exported struct field along with its name in a path.
+
+Index is the index sequence of the field in the struct like reflect.StructField.Index. It has more than one element for fields promoted from embedded structs.
+*/
+type StructField struct {
+	Name  string
+	Index []int
+	Field reflect.StructField
+}
+
+/*
+Value returns the field in structValue.
+
+Returns an invalid reflect.Value if the field is promoted through a nil pointer to an embedded struct.
+*/
+func (n StructField) Value(structValue reflect.Value) reflect.Value {
+	fieldValue, err := structValue.FieldByIndexErr(n.Index)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return fieldValue
+}
+
+/*
+SettableValue returns the field in structValue allocating nil pointers to embedded structs the field is promoted through.
+
+Returns an invalid reflect.Value if a nil pointer cannot be set.
+*/
+func (n StructField) SettableValue(structValue reflect.Value) reflect.Value {
+	fieldValue := structValue
+	for i, index := range n.Index {
+		if i > 0 && fieldValue.Kind() == reflect.Pointer {
+			if fieldValue.IsNil() {
+				if !fieldValue.CanSet() {
+					return reflect.Value{}
+				}
+				fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		fieldValue = fieldValue.Field(index)
+	}
+	return fieldValue
+}
+
+// structFields are the fields of a struct type that can be resolved by a FieldNameStrategy.
+type structFields struct {
+	// In the order they are declared with promoted fields in place of the embedded struct.
+	list []StructField
+
+	// Fields by name. Includes embedded structs that are promoted which are not in list.
+	byName map[string]StructField
+
+	// Fields by lower case name. Set if FieldNameStrategy.caseInsensitive.
+	byFoldedName map[string]StructField
+
+	// Names of fields that are ambiguous.
+	ambiguous map[string]bool
+
+	// Lower case names of fields that are ambiguous. Set if FieldNameStrategy.caseInsensitive.
+	foldedAmbiguous map[string]bool
+}
+
+var (
+	// FieldNameGo resolves fields by their Go name e.g., `FirstName`.
+	FieldNameGo = NewFieldNameStrategy(GoFieldName)
+
+	// FieldNameJSONTag resolves fields by the name in their `json` tag like encoding/json. Fields without a name in the tag use their Go name and fields tagged `json:"-"` are skipped.
+	FieldNameJSONTag = NewTagFieldNameStrategy("json")
+
+	// FieldNameYAMLTag resolves fields by the name in their `yaml` tag. Fields without a name in the tag use their Go name and fields tagged `yaml:"-"` are skipped.
+	FieldNameYAMLTag = NewTagFieldNameStrategy("yaml")
+
+	// FieldNameCaseInsensitive resolves fields by their Go name ignoring case e.g., `firstname` or `FIRSTNAME` for `FirstName`.
+	FieldNameCaseInsensitive = NewFieldNameStrategy(GoFieldName).WithCaseInsensitive(true)
+)
+
+/*
+NewFieldNameStrategy creates a FieldNameStrategy that uses fieldName to get the name of each exported struct field.
+
+fieldName returns false if the field cannot be addressed by a path.
+
+Example:
+
+	strategy := core.NewFieldNameStrategy(func(field reflect.StructField) (string, bool) {
+		return strcase.ToSnake(field.Name), true
+	})
+*/
+func NewFieldNameStrategy(fieldName func(field reflect.StructField) (string, bool)) *FieldNameStrategy {
+	return &FieldNameStrategy{fieldName: fieldName}
+}
+
+// NewTagFieldNameStrategy creates a FieldNameStrategy that uses TagFieldName with tagKey e.g., `toml`.
+func NewTagFieldNameStrategy(tagKey string) *FieldNameStrategy {
+	return &FieldNameStrategy{fieldName: TagFieldName(tagKey), tagKey: tagKey}
+}
+
+/*
+WithCaseInsensitive returns a new FieldNameStrategy with the same field names that are matched ignoring case if value is true.
+
+A new FieldNameStrategy is returned so that predefined strategies are never modified.
+*/
+func (n *FieldNameStrategy) WithCaseInsensitive(value bool) *FieldNameStrategy {
+	n = n.orDefault()
+	return &FieldNameStrategy{fieldName: n.fieldName, tagKey: n.tagKey, caseInsensitive: value}
+}
+
+// GoFieldName returns the Go name of field.
+func GoFieldName(field reflect.StructField) (string, bool) {
+	return field.Name, true
+}
+
+/*
+TagFieldName returns a function for NewFieldNameStrategy that gets the name of a field from the struct tag with key tagKey e.g., `json`.
+
+The name is the part of the tag before the first comma. If it is empty, the Go name is used. If the tag is `-`, the field is skipped.
+*/
+func TagFieldName(tagKey string) func(field reflect.StructField) (string, bool) {
+	return func(field reflect.StructField) (string, bool) {
+		tag, ok := field.Tag.Lookup(tagKey)
+		if !ok {
+			return field.Name, true
+		}
+		if tag == "-" {
+			return "", false
+		}
+		if name, _, _ := strings.Cut(tag, ","); name != "" {
+			return name, true
+		}
+		return field.Name, true
+	}
+}
+
+// Fields returns the exported fields of structType that can be addressed by a path in the order they are declared.
+func (n *FieldNameStrategy) Fields(structType reflect.Type) []StructField {
+	return n.orDefault().getStructFields(structType).list
+}
+
+/*
+Field returns the exported field of structType with name and true, or false if there is no such field or it is ambiguous.
+
+Embedded structs whose fields are promoted can also be resolved by their own name like in Go.
+*/
+func (n *FieldNameStrategy) Field(structType reflect.Type, name string) (StructField, bool) {
+	n = n.orDefault()
+	fields := n.getStructFields(structType)
+
+	if field, ok := fields.byName[name]; ok {
+		return field, true
+	}
+	if fields.ambiguous[name] {
+		return StructField{}, false
+	}
+	if n.caseInsensitive {
+		if field, ok := fields.byFoldedName[strings.ToLower(name)]; ok {
+			return field, true
+		}
+	}
+	return StructField{}, false
+}
+
+// IsAmbiguous returns true if name cannot be resolved in structType because more than one embedded struct promotes a field with name at the same depth.
+func (n *FieldNameStrategy) IsAmbiguous(structType reflect.Type, name string) bool {
+	n = n.orDefault()
+	fields := n.getStructFields(structType)
+
+	if _, ok := fields.byName[name]; ok {
+		return false
+	}
+	if fields.ambiguous[name] {
+		return true
+	}
+	if n.caseInsensitive {
+		if _, ok := fields.byFoldedName[strings.ToLower(name)]; ok {
+			return false
+		}
+		return fields.foldedAmbiguous[strings.ToLower(name)]
+	}
+	return false
+}
+
+/*
+FieldName returns the name in a path of the exported field of structType with the Go name goName.
+
+Returns false if there is no such field or it cannot be addressed by a path.
+*/
+func (n *FieldNameStrategy) FieldName(structType reflect.Type, goName string) (string, bool) {
+	for _, field := range n.Fields(structType) {
+		if field.Field.Name == goName {
+			return field.Name, true
+		}
+	}
+	return "", false
+}
+
+// orDefault returns FieldNameGo if n is nil.
+func (n *FieldNameStrategy) orDefault() *FieldNameStrategy {
+	if n == nil {
+		return FieldNameGo
+	}
+	return n
+}
+
+// getStructFields returns the cached structFields of structType.
+func (n *FieldNameStrategy) getStructFields(structType reflect.Type) *structFields {
+	if fields, ok := n.structFields.Load(structType); ok {
+		return fields.(*structFields)
+	}
+
+	fields := &structFields{
+		list:      make([]StructField, 0, structType.NumField()),
+		byName:    make(map[string]StructField),
+		ambiguous: make(map[string]bool),
+	}
+
+	type embeddedStruct struct {
+		structType reflect.Type
+		index      []int
+	}
+
+	type depthField struct {
+		StructField
+		// If true, field is an embedded struct whose fields are promoted.
+		promoted bool
+		// If true, field has a name in the struct tag used by the strategy.
+		tagged bool
+	}
+
+	// Breadth first so that fields at a shallower depth hide fields with the same name at a deeper depth.
+	current := []embeddedStruct{{structType: structType}}
+	visited := make(map[reflect.Type]bool)
+	for len(current) > 0 {
+		next := make([]embeddedStruct, 0)
+		depthFields := make(map[string][]depthField)
+		depthFieldNames := make([]string, 0)
+
+		depthVisited := make(map[reflect.Type]bool)
+		for _, embedded := range current {
+			// the same struct embedded more than once at a depth is not skipped so that its fields are ambiguous.
+			if visited[embedded.structType] {
+				continue
+			}
+			depthVisited[embedded.structType] = true
+
+			for i := 0; i < embedded.structType.NumField(); i++ {
+				field := embedded.structType.Field(i)
+				index := append(append(make([]int, 0, len(embedded.index)+1), embedded.index...), i)
+
+				fieldType := field.Type
+				if fieldType.Kind() == reflect.Pointer {
+					fieldType = fieldType.Elem()
+				}
+				isEmbeddedStruct := field.Anonymous && fieldType.Kind() == reflect.Struct
+
+				if !field.IsExported() {
+					// Exported fields of unexported embedded structs are promoted unless the struct cannot be allocated.
+					if isEmbeddedStruct && field.Type.Kind() != reflect.Pointer {
+						next = append(next, embeddedStruct{structType: fieldType, index: index})
+					}
+					continue
+				}
+
+				name, ok := n.fieldName(field)
+				if !ok {
+					continue
+				}
+
+				tagged := n.isTaggedWithName(field)
+				promoted := isEmbeddedStruct && !tagged
+				if promoted {
+					next = append(next, embeddedStruct{structType: fieldType, index: index})
+				}
+
+				if _, ok := depthFields[name]; !ok {
+					depthFieldNames = append(depthFieldNames, name)
+				}
+				depthFields[name] = append(depthFields[name], depthField{StructField: StructField{Name: name, Index: index, Field: field}, promoted: promoted, tagged: tagged})
+			}
+		}
+
+		for _, name := range depthFieldNames {
+			if _, ok := fields.byName[name]; ok || fields.ambiguous[name] {
+				// hidden by a field at a shallower depth.
+				continue
+			}
+
+			candidates := depthFields[name]
+			if len(candidates) > 1 {
+				// like encoding/json, a field tagged with the name wins if it is the only one.
+				taggedCandidates := slices.DeleteFunc(slices.Clone(candidates), func(candidate depthField) bool { return !candidate.tagged })
+				if len(taggedCandidates) != 1 {
+					fields.ambiguous[name] = true
+					continue
+				}
+				candidates = taggedCandidates
+			}
+
+			fields.byName[name] = candidates[0].StructField
+			if !candidates[0].promoted {
+				fields.list = append(fields.list, candidates[0].StructField)
+			}
+		}
+
+		maps.Copy(visited, depthVisited)
+		current = next
+	}
+
+	slices.SortFunc(fields.list, func(a, b StructField) int {
+		return slices.Compare(a.Index, b.Index)
+	})
+
+	if n.caseInsensitive {
+		fields.byFoldedName = make(map[string]StructField)
+		fields.foldedAmbiguous = make(map[string]bool)
+
+		byName := slices.SortedFunc(maps.Values(fields.byName), func(a, b StructField) int {
+			return slices.Compare(a.Index, b.Index)
+		})
+		for _, field := range byName {
+			// the first field with a name wins.
+			if _, ok := fields.byFoldedName[strings.ToLower(field.Name)]; !ok {
+				fields.byFoldedName[strings.ToLower(field.Name)] = field
+			}
+		}
+		for name := range fields.ambiguous {
+			if _, ok := fields.byFoldedName[strings.ToLower(name)]; !ok {
+				fields.foldedAmbiguous[strings.ToLower(name)] = true
+			}
+		}
+	}
+
+	actual, _ := n.structFields.LoadOrStore(structType, fields)
+	return actual.(*structFields)
+}
+
+// isTaggedWithName returns true if field has a name in the struct tag used by the FieldNameStrategy.
+func (n *FieldNameStrategy) isTaggedWithName(field reflect.StructField) bool {
+	if n.tagKey == "" {
+		return false
+	}
+	tag, _, _ := strings.Cut(field.Tag.Get(n.tagKey), ",")
+	return tag != "" && tag != "-"
+}
@@ -0,0 +1,307 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rogonion/go-json/internal"
+)
+
+type fieldNameStrategyTestStruct struct {
+	FirstName string `json:"first_name" yaml:"firstName"`
+	LastName  string `json:",omitempty"`
+	Password  string `json:"-" yaml:"-"`
+	Email     string
+	Duplicate string `json:"Email"`
+	internal  string
+}
+
+func TestCore_FieldNameStrategy_Field(t *testing.T) {
+	for testData := range FieldNameStrategyFieldTestData {
+		structField, ok := testData.Strategy.Field(reflect.TypeOf(fieldNameStrategyTestStruct{}), testData.Name)
+
+		if ok != testData.ExpectedOk {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected ok=", testData.ExpectedOk, "\n",
+				"got=", ok,
+			)
+		}
+
+		if ok && structField.Field.Name != testData.ExpectedGoName {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected Go name=", testData.ExpectedGoName, "\n",
+				"got=", structField.Field.Name,
+			)
+		}
+	}
+}
+
+type FieldNameStrategyFieldData struct {
+	internal.TestData
+	Strategy       *FieldNameStrategy
+	Name           string
+	ExpectedOk     bool
+	ExpectedGoName string
+}
+
+func FieldNameStrategyFieldTestData(yield func(data *FieldNameStrategyFieldData) bool) {
+	testCaseIndex := 1
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: nil strategy resolves Go names", testCaseIndex),
+			},
+			Name:           "FirstName",
+			ExpectedOk:     true,
+			ExpectedGoName: "FirstName",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Go name strategy does not resolve json tag", testCaseIndex),
+			},
+			Strategy: FieldNameGo,
+			Name:     "first_name",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: unexported field", testCaseIndex),
+			},
+			Strategy: FieldNameGo,
+			Name:     "internal",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: json tag", testCaseIndex),
+			},
+			Strategy:       FieldNameJSONTag,
+			Name:           "first_name",
+			ExpectedOk:     true,
+			ExpectedGoName: "FirstName",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: json tag without name uses Go name", testCaseIndex),
+			},
+			Strategy:       FieldNameJSONTag,
+			Name:           "LastName",
+			ExpectedOk:     true,
+			ExpectedGoName: "LastName",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: json tag `-` is skipped", testCaseIndex),
+			},
+			Strategy: FieldNameJSONTag,
+			Name:     "Password",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: field tagged with a name wins over field with the same Go name", testCaseIndex),
+			},
+			Strategy:       FieldNameJSONTag,
+			Name:           "Email",
+			ExpectedOk:     true,
+			ExpectedGoName: "Duplicate",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: yaml tag", testCaseIndex),
+			},
+			Strategy:       FieldNameYAMLTag,
+			Name:           "firstName",
+			ExpectedOk:     true,
+			ExpectedGoName: "FirstName",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: case insensitive", testCaseIndex),
+			},
+			Strategy:       FieldNameCaseInsensitive,
+			Name:           "FIRSTNAME",
+			ExpectedOk:     true,
+			ExpectedGoName: "FirstName",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: case insensitive json tag", testCaseIndex),
+			},
+			Strategy:       FieldNameJSONTag.WithCaseInsensitive(true),
+			Name:           "First_Name",
+			ExpectedOk:     true,
+			ExpectedGoName: "FirstName",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FieldNameStrategyFieldData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: custom strategy", testCaseIndex),
+			},
+			Strategy: NewFieldNameStrategy(func(field reflect.StructField) (string, bool) {
+				return strings.ToUpper(field.Name), true
+			}),
+			Name:           "EMAIL",
+			ExpectedOk:     true,
+			ExpectedGoName: "Email",
+		},
+	) {
+		return
+	}
+}
+
+func TestCore_FieldNameStrategy_Fields(t *testing.T) {
+	structType := reflect.TypeOf(fieldNameStrategyTestStruct{})
+
+	names := make([]string, 0)
+	for _, structField := range FieldNameJSONTag.Fields(structType) {
+		names = append(names, structField.Name)
+	}
+	if expected := []string{"first_name", "LastName", "Email"}; !reflect.DeepEqual(names, expected) {
+		t.Error("expected=", expected, "got=", names)
+	}
+
+	if name, ok := FieldNameYAMLTag.FieldName(structType, "FirstName"); !ok || name != "firstName" {
+		t.Error("expected=firstName got=", name, ok)
+	}
+	if _, ok := FieldNameYAMLTag.FieldName(structType, "Password"); ok {
+		t.Error("expected Password to be skipped")
+	}
+
+	if FieldNameGo.WithCaseInsensitive(true) == FieldNameGo {
+		t.Error("expected WithCaseInsensitive to return a new FieldNameStrategy")
+	}
+}
+
+type EmbeddedTestBase struct {
+	ID      int `json:"id"`
+	Version int
+}
+
+type EmbeddedTestAudit struct {
+	CreatedBy string `json:"created_by"`
+	Version   int
+}
+
+type EmbeddedTestNamed struct {
+	Note string
+}
+
+type embeddedTestHidden struct {
+	Hidden string
+}
+
+type embeddedTestStruct struct {
+	EmbeddedTestBase
+	*EmbeddedTestAudit
+	EmbeddedTestNamed `json:"named"`
+	embeddedTestHidden
+	Name string
+	ID   string `json:"-"`
+}
+
+func TestCore_FieldNameStrategy_Embedded(t *testing.T) {
+	structType := reflect.TypeOf(embeddedTestStruct{})
+
+	names := make([]string, 0)
+	for _, structField := range FieldNameJSONTag.Fields(structType) {
+		names = append(names, structField.Name)
+	}
+	if expected := []string{"id", "created_by", "named", "Hidden", "Name"}; !reflect.DeepEqual(names, expected) {
+		t.Error("expected=", expected, "got=", names)
+	}
+
+	if structField, ok := FieldNameJSONTag.Field(structType, "id"); !ok || !reflect.DeepEqual(structField.Index, []int{0, 0}) {
+		t.Error("expected promoted field with index [0 0], got=", structField, ok)
+	}
+	if _, ok := FieldNameJSONTag.Field(structType, "Version"); ok || !FieldNameJSONTag.IsAmbiguous(structType, "Version") {
+		t.Error("expected Version to be ambiguous")
+	}
+	if FieldNameJSONTag.IsAmbiguous(structType, "missing") {
+		t.Error("expected missing field to not be ambiguous")
+	}
+	if _, ok := FieldNameCaseInsensitive.Field(structType, "VERSION"); ok || !FieldNameCaseInsensitive.IsAmbiguous(structType, "VERSION") {
+		t.Error("expected VERSION to be ambiguous ignoring case")
+	}
+
+	// Go name hides the promoted field since it is at a shallower depth.
+	if structField, ok := FieldNameGo.Field(structType, "ID"); !ok || !reflect.DeepEqual(structField.Index, []int{5}) {
+		t.Error("expected ID at index [5], got=", structField, ok)
+	}
+	if _, ok := FieldNameGo.Field(structType, "Note"); !ok {
+		t.Error("expected Note to be promoted without a tag based strategy")
+	}
+	if _, ok := FieldNameJSONTag.Field(structType, "Note"); ok {
+		t.Error("expected Note to not be promoted from struct tagged with a name")
+	}
+
+	value := reflect.ValueOf(&embeddedTestStruct{}).Elem()
+	createdBy, _ := FieldNameJSONTag.Field(structType, "created_by")
+	if createdBy.Value(value).IsValid() {
+		t.Error("expected invalid value for field promoted through nil pointer")
+	}
+	createdBy.SettableValue(value).SetString("admin")
+	if value.Interface().(embeddedTestStruct).CreatedBy != "admin" {
+		t.Error("expected embedded pointer to be allocated and field set")
+	}
+}
@@ -1,9 +1,11 @@
 package object
 
 import (
+	"math"
 	"reflect"
 
 	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
 )
 
 /*
@@ -49,13 +51,30 @@ func (n *AreEqual) AreEqual(left any, right any) bool {
 }
 
 // AreEqualReflect performs deep equality check on reflect.Values.
-// It supports custom equality handlers registered via WithCustomEquals.
+// It supports custom equality handlers registered via WithCustomEquals and the options set with WithOptions.
 func (n *AreEqual) AreEqualReflect(left reflect.Value, right reflect.Value) bool {
+	if !n.hasOptions {
+		return n.areEqual(left, right, nil)
+	}
+	return n.areEqual(left, right, path.RecursiveDescentSegment{{Key: path.JsonpathKeyRoot, IsKeyRoot: true}})
+}
+
+// areEqual is the recursive implementation of AreEqualReflect. currentPath is the concrete path to left and right used to match the paths in AreEqualOptions.
+func (n *AreEqual) areEqual(left reflect.Value, right reflect.Value, currentPath path.RecursiveDescentSegment) bool {
+	if n.isIgnoredPath(currentPath) {
+		return true
+	}
+
+	if n.coerce() {
+		left = unwrapInterface(left)
+		right = unwrapInterface(right)
+	}
+
 	leftNilOrInvalid := core.IsNilOrInvalid(left)
 	rightNilOrInvalid := core.IsNilOrInvalid(right)
 
-	if (!leftNilOrInvalid && rightNilOrInvalid) || (!rightNilOrInvalid && leftNilOrInvalid) {
-		return false
+	if leftNilOrInvalid != rightNilOrInvalid {
+		return n.options.NilEqualsEmpty && (isEmptyCollection(left) || isEmptyCollection(right))
 	}
 
 	if leftNilOrInvalid {
@@ -63,6 +82,16 @@ func (n *AreEqual) AreEqualReflect(left reflect.Value, right reflect.Value) bool
 	}
 
 	if left.Kind() != right.Kind() {
+		if n.coerce() && (left.Kind() == reflect.Pointer || right.Kind() == reflect.Pointer) {
+			// e.g., a pointer to a struct compared with a map.
+			return n.areEqual(indirectValue(left), indirectValue(right), currentPath)
+		}
+		if n.options.NumericCoercion && isNumberKind(left.Kind()) && isNumberKind(right.Kind()) {
+			return n.numbersEqual(left, right)
+		}
+		if n.options.StructMapCoercion && isStructMapPair(left, right) {
+			return n.membersEqual(left, right, currentPath)
+		}
 		return false
 	}
 
@@ -72,18 +101,26 @@ func (n *AreEqual) AreEqualReflect(left reflect.Value, right reflect.Value) bool
 
 	switch left.Kind() {
 	case reflect.Ptr, reflect.Interface:
-		return n.AreEqualReflect(left.Elem(), right.Elem())
+		return n.areEqual(left.Elem(), right.Elem(), currentPath)
 	case reflect.Slice, reflect.Array:
+		if n.isUnorderedPath(currentPath) {
+			return n.unorderedElementsEqual(left, right, currentPath)
+		}
+
 		if left.Len() != right.Len() {
 			return false
 		}
 
 		for i := 0; i < left.Len(); i++ {
-			if !n.AreEqualReflect(left.Index(i), right.Index(i)) {
+			if !n.areEqual(left.Index(i), right.Index(i), n.indexPath(currentPath, i)) {
 				return false
 			}
 		}
 	case reflect.Map:
+		if n.hasOptions {
+			return n.membersEqual(left, right, currentPath)
+		}
+
 		leftMapKeys := left.MapKeys()
 		rightMapKeys := right.MapKeys()
 
@@ -114,10 +151,15 @@ func (n *AreEqual) AreEqualReflect(left reflect.Value, right reflect.Value) bool
 			return false
 		}
 		for i := 0; i < leftNumFields; i++ {
-			if !n.AreEqualReflect(left.Field(i), right.Field(i)) {
+			if !n.areEqual(left.Field(i), right.Field(i), n.keyPath(currentPath, left.Type().Field(i).Name)) {
 				return false
 			}
 		}
+	case reflect.Float32, reflect.Float64:
+		if n.options.FloatAbsoluteTolerance > 0 || n.options.FloatRelativeTolerance > 0 {
+			return n.numbersEqual(left, right)
+		}
+		return reflect.DeepEqual(left.Interface(), right.Interface())
 	default:
 		return reflect.DeepEqual(left.Interface(), right.Interface())
 	}
@@ -125,6 +167,220 @@ func (n *AreEqual) AreEqualReflect(left reflect.Value, right reflect.Value) bool
 	return true
 }
 
+// indexPath returns currentPath with the index added. The path is only tracked if options are set.
+func (n *AreEqual) indexPath(currentPath path.RecursiveDescentSegment, index int) path.RecursiveDescentSegment {
+	if !n.hasOptions {
+		return currentPath
+	}
+	return append(currentPath, &path.CollectionMemberSegment{Index: index, IsIndex: true})
+}
+
+// keyPath returns currentPath with the key added. The path is only tracked if options are set.
+func (n *AreEqual) keyPath(currentPath path.RecursiveDescentSegment, key string) path.RecursiveDescentSegment {
+	if !n.hasOptions {
+		return currentPath
+	}
+	return append(currentPath, &path.CollectionMemberSegment{Key: key, IsKey: true})
+}
+
+// isIgnoredPath returns true if currentPath matches one of AreEqualOptions.IgnorePaths.
+func (n *AreEqual) isIgnoredPath(currentPath path.RecursiveDescentSegment) bool {
+	for _, ignorePath := range n.ignorePaths {
+		if ignorePath.Matches(currentPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnorderedPath returns true if currentPath matches one of AreEqualOptions.UnorderedPaths.
+func (n *AreEqual) isUnorderedPath(currentPath path.RecursiveDescentSegment) bool {
+	for _, unorderedPath := range n.unorderedPaths {
+		if unorderedPath.Matches(currentPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// coerce returns true if values of different kinds may be equal hence interfaces have to be unwrapped before comparing kinds.
+func (n *AreEqual) coerce() bool {
+	return n.options.NumericCoercion || n.options.StructMapCoercion || n.options.NilEqualsEmpty
+}
+
+// numbersEqual compares two numbers of any kind taking the float tolerances in AreEqualOptions into account.
+func (n *AreEqual) numbersEqual(left reflect.Value, right reflect.Value) bool {
+	if compareNumbers(left, right) == 0 {
+		return true
+	}
+	if (left.CanInt() || left.CanUint()) && (right.CanInt() || right.CanUint()) {
+		return false
+	}
+
+	leftFloat := numberAsFloat(left)
+	rightFloat := numberAsFloat(right)
+	difference := math.Abs(leftFloat - rightFloat)
+	if difference <= n.options.FloatAbsoluteTolerance {
+		return true
+	}
+	return difference <= n.options.FloatRelativeTolerance*math.Max(math.Abs(leftFloat), math.Abs(rightFloat))
+}
+
+/*
+membersEqual compares the members of two maps, or a map and a struct, by key.
+
+Members at ignored paths are skipped. If AreEqualOptions.NilEqualsEmpty is set, a member that is missing on one side is equal to a nil or empty member on the other side.
+*/
+func (n *AreEqual) membersEqual(left reflect.Value, right reflect.Value, currentPath path.RecursiveDescentSegment) bool {
+	rightMembers := getCollectionMembers(right, n.options.FieldNameStrategy)
+	rightMatched := make([]bool, len(rightMembers))
+
+	for _, leftMember := range getCollectionMembers(left, n.options.FieldNameStrategy) {
+		memberPath := append(currentPath, leftMember.segment)
+		if n.isIgnoredPath(memberPath) {
+			continue
+		}
+
+		matched := false
+		for i, rightMember := range rightMembers {
+			if rightMatched[i] || !n.memberKeysEqual(leftMember, rightMember) {
+				continue
+			}
+			rightMatched[i] = true
+			matched = true
+			if !n.areEqual(leftMember.value, rightMember.value, memberPath) {
+				return false
+			}
+			break
+		}
+		if !matched && !(n.options.NilEqualsEmpty && isNilOrEmpty(leftMember.value)) {
+			return false
+		}
+	}
+
+	for i, rightMember := range rightMembers {
+		if rightMatched[i] || n.isIgnoredPath(append(currentPath, rightMember.segment)) {
+			continue
+		}
+		if !(n.options.NilEqualsEmpty && isNilOrEmpty(rightMember.value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// memberKeysEqual compares map keys with AreEqualReflect, and struct field names with map keys by their string form.
+func (n *AreEqual) memberKeysEqual(left collectionMember, right collectionMember) bool {
+	if left.mapKey.IsValid() && right.mapKey.IsValid() {
+		return n.AreEqualReflect(left.mapKey, right.mapKey)
+	}
+	return left.segment.Key == right.segment.Key
+}
+
+/*
+unorderedElementsEqual checks if two arrays or slices have the same elements regardless of their order.
+
+Each element on the left must be matched with a different equal element on the right. Since equality may not be transitive e.g., with float tolerances,
+the elements are matched with augmenting paths instead of taking the first equal element.
+*/
+func (n *AreEqual) unorderedElementsEqual(left reflect.Value, right reflect.Value, currentPath path.RecursiveDescentSegment) bool {
+	if left.Len() != right.Len() {
+		return false
+	}
+
+	equalElements := make([][]int, left.Len())
+	for i := 0; i < left.Len(); i++ {
+		for j := 0; j < right.Len(); j++ {
+			if n.areEqual(left.Index(i), right.Index(j), n.indexPath(currentPath, i)) {
+				equalElements[i] = append(equalElements[i], j)
+			}
+		}
+		if len(equalElements[i]) == 0 {
+			return false
+		}
+	}
+
+	// rightMatch is the index of the left element matched with each right element or -1.
+	rightMatch := make([]int, right.Len())
+	for j := range rightMatch {
+		rightMatch[j] = -1
+	}
+
+	var match func(i int, visited []bool) bool
+	match = func(i int, visited []bool) bool {
+		for _, j := range equalElements[i] {
+			if visited[j] {
+				continue
+			}
+			visited[j] = true
+			if rightMatch[j] == -1 || match(rightMatch[j], visited) {
+				rightMatch[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range equalElements {
+		if !match(i, make([]bool, right.Len())) {
+			return false
+		}
+	}
+	return true
+}
+
+// unwrapInterface returns the value held by an interface.
+func unwrapInterface(value reflect.Value) reflect.Value {
+	for value.IsValid() && value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
+
+// isEmptyCollection returns true if value is a map, slice, or array with no elements.
+func isEmptyCollection(value reflect.Value) bool {
+	value = unwrapInterface(value)
+	if !value.IsValid() {
+		return false
+	}
+	switch value.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		return value.Len() == 0
+	default:
+		return false
+	}
+}
+
+// isNilOrEmpty returns true if value is nil, invalid, or an empty collection.
+func isNilOrEmpty(value reflect.Value) bool {
+	return core.IsNilOrInvalid(unwrapInterface(value)) || isEmptyCollection(value)
+}
+
+// isStructMapPair returns true if one of left and right is a struct and the other is a map.
+func isStructMapPair(left reflect.Value, right reflect.Value) bool {
+	return (left.Kind() == reflect.Struct && right.Kind() == reflect.Map) || (left.Kind() == reflect.Map && right.Kind() == reflect.Struct)
+}
+
+// WithOptions sets the options that relax the equality checks. See AreEqualOptions.
+func (n *AreEqual) WithOptions(value AreEqualOptions) *AreEqual {
+	n.SetOptions(value)
+	return n
+}
+
+func (n *AreEqual) SetOptions(value AreEqualOptions) {
+	n.options = value
+	n.hasOptions = !reflect.ValueOf(value).IsZero()
+	n.ignorePaths = make([]path.RecursiveDescentSegments, 0, len(value.IgnorePaths))
+	for _, ignorePath := range value.IgnorePaths {
+		n.ignorePaths = append(n.ignorePaths, ignorePath.Parse())
+	}
+	n.unorderedPaths = make([]path.RecursiveDescentSegments, 0, len(value.UnorderedPaths))
+	for _, unorderedPath := range value.UnorderedPaths {
+		n.unorderedPaths = append(n.unorderedPaths, unorderedPath.Parse())
+	}
+}
+
 func (n *AreEqual) WithCustomEquals(value AreEquals) *AreEqual {
 	n.customEquals = value
 	return n
@@ -147,4 +403,46 @@ type AreEqual struct {
 	//
 	// Useful for user defined types like structs.
 	customEquals AreEquals
+
+	// Set with WithOptions or SetOptions.
+	options AreEqualOptions
+	// true if options is not the zero value.
+	hasOptions bool
+	// Parsed AreEqualOptions.IgnorePaths.
+	ignorePaths []path.RecursiveDescentSegments
+	// Parsed AreEqualOptions.UnorderedPaths.
+	unorderedPaths []path.RecursiveDescentSegments
+}
+
+/*
+AreEqualOptions relax the checks made by AreEqual. The zero value keeps AreEqual strict.
+
+Paths are JSONPaths matched against the concrete path of each value being compared e.g., `$..updatedAt` or `$.items[*].id`.
+
+To compare a document decoded from JSON with a typed struct, set NumericCoercion, StructMapCoercion, and NilEqualsEmpty.
+*/
+type AreEqualOptions struct {
+	// Values at these paths are not compared. Map members at these paths may be missing on either side.
+	IgnorePaths []path.JSONPath
+
+	// Two floats are equal if the absolute difference between them is at most FloatAbsoluteTolerance.
+	FloatAbsoluteTolerance float64
+	// Two floats are equal if the absolute difference between them is at most FloatRelativeTolerance times the larger of their absolute values.
+	FloatRelativeTolerance float64
+
+	// Arrays and slices at these paths are equal if they have the same elements in any order.
+	UnorderedPaths []path.JSONPath
+
+	// A nil value, or a missing map member, is equal to an empty map, slice, or array.
+	NilEqualsEmpty bool
+
+	// Numbers of different kinds e.g., float64(1) and int(1), are compared by value.
+	NumericCoercion bool
+
+	// A struct is compared with a map member by member using the field names as keys.
+	StructMapCoercion bool
+
+	// How the names of struct fields are resolved when a struct is compared with a map e.g., core.FieldNameJSONTag to use the names in `json` tags.
+	// Defaults to the Go name of the field.
+	FieldNameStrategy *core.FieldNameStrategy
 }
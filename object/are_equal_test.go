@@ -6,11 +6,12 @@ import (
 
 	"github.com/rogonion/go-json/core"
 	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
 )
 
 func TestObject_AreEqual(t *testing.T) {
 	for testData := range AreEqualTestData {
-		if NewAreEqual().WithCustomEquals(testData.CustomAreEquals).AreEqual(testData.Left, testData.Right) != testData.Expected {
+		if NewAreEqual().WithCustomEquals(testData.CustomAreEquals).WithOptions(testData.Options).AreEqual(testData.Left, testData.Right) != testData.Expected {
 			t.Error(
 				testData.TestTitle, "\n",
 				"Result of AreEqual not equal to testData.Expected\n",
@@ -27,6 +28,7 @@ type AreEqualData struct {
 	Left, Right     any
 	Expected        bool
 	CustomAreEquals AreEquals
+	Options         AreEqualOptions
 }
 
 func AreEqualTestData(yield func(data *AreEqualData) bool) {
@@ -149,4 +151,244 @@ func AreEqualTestData(yield func(data *AreEqualData) bool) {
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Ignore paths", testCaseIndex),
+			},
+			Left:     map[string]any{"id": 1, "updatedAt": "2024-01-01", "items": []any{map[string]any{"id": 1, "updatedAt": "2024-01-01"}}},
+			Right:    map[string]any{"id": 1, "updatedAt": "2025-06-30", "items": []any{map[string]any{"id": 1}}},
+			Expected: true,
+			Options:  AreEqualOptions{IgnorePaths: []path.JSONPath{"$..updatedAt"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Ignore paths do not hide other differences", testCaseIndex),
+			},
+			Left:     map[string]any{"id": 1, "updatedAt": "2024-01-01"},
+			Right:    map[string]any{"id": 2, "updatedAt": "2025-06-30"},
+			Expected: false,
+			Options:  AreEqualOptions{IgnorePaths: []path.JSONPath{"$..updatedAt"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Float absolute tolerance", testCaseIndex),
+			},
+			Left:     []any{1.0, 2.0},
+			Right:    []any{1.0000001, 1.9999999},
+			Expected: true,
+			Options:  AreEqualOptions{FloatAbsoluteTolerance: 1e-6},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Float outside absolute tolerance", testCaseIndex),
+			},
+			Left:     1.0,
+			Right:    1.001,
+			Expected: false,
+			Options:  AreEqualOptions{FloatAbsoluteTolerance: 1e-6},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Float relative tolerance", testCaseIndex),
+			},
+			Left:     1000000.0,
+			Right:    1000001.0,
+			Expected: true,
+			Options:  AreEqualOptions{FloatRelativeTolerance: 1e-5},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Unordered paths", testCaseIndex),
+			},
+			Left:     map[string]any{"tags": []any{"a", "b", "c"}, "order": []any{1, 2}},
+			Right:    map[string]any{"tags": []any{"c", "a", "b"}, "order": []any{1, 2}},
+			Expected: true,
+			Options:  AreEqualOptions{UnorderedPaths: []path.JSONPath{"$.tags"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Order still matters outside unordered paths", testCaseIndex),
+			},
+			Left:     map[string]any{"tags": []any{"a", "b"}, "order": []any{1, 2}},
+			Right:    map[string]any{"tags": []any{"b", "a"}, "order": []any{2, 1}},
+			Expected: false,
+			Options:  AreEqualOptions{UnorderedPaths: []path.JSONPath{"$.tags"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Unordered paths with duplicate elements", testCaseIndex),
+			},
+			Left:     []any{1, 1, 2},
+			Right:    []any{1, 2, 2},
+			Expected: false,
+			Options:  AreEqualOptions{UnorderedPaths: []path.JSONPath{"$"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Unordered paths with float tolerance", testCaseIndex),
+			},
+			Left:     map[string]any{"a": []any{1.0, 1.2}},
+			Right:    map[string]any{"a": []any{1.1, 1.0}},
+			Expected: true,
+			Options:  AreEqualOptions{UnorderedPaths: []path.JSONPath{"$.a"}, FloatAbsoluteTolerance: 0.15},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Nil equals empty", testCaseIndex),
+			},
+			Left:     map[string]any{"a": map[string]any(nil), "b": []any{}},
+			Right:    map[string]any{"a": map[string]any{}, "c": nil},
+			Expected: true,
+			Options:  AreEqualOptions{NilEqualsEmpty: true},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Nil is not equal to a non-empty value", testCaseIndex),
+			},
+			Left:     map[string]any{"a": nil},
+			Right:    map[string]any{"a": []any{1}},
+			Expected: false,
+			Options:  AreEqualOptions{NilEqualsEmpty: true},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Numeric coercion", testCaseIndex),
+			},
+			Left:     map[string]any{"a": float64(1), "b": uint8(2)},
+			Right:    map[string]any{"a": 1, "b": int64(2)},
+			Expected: true,
+			Options:  AreEqualOptions{NumericCoercion: true},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Numeric coercion compares by value", testCaseIndex),
+			},
+			Left:     float64(1.5),
+			Right:    1,
+			Expected: false,
+			Options:  AreEqualOptions{NumericCoercion: true},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: JSON decoded map and typed struct", testCaseIndex),
+			},
+			Left:     map[string]any{"Name": "Alice", "Age": float64(30), "Address": map[string]any{"Street": "1 Main St", "City": "Anytown"}},
+			Right:    &UserProfile{Name: "Alice", Age: 30, Address: Address{Street: "1 Main St", City: "Anytown"}},
+			Expected: true,
+			Options:  AreEqualOptions{NumericCoercion: true, StructMapCoercion: true, NilEqualsEmpty: true},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: JSON decoded map and typed struct with different member", testCaseIndex),
+			},
+			Left:     map[string]any{"Name": "Alice", "Age": float64(31), "Address": map[string]any{"Street": "1 Main St", "City": "Anytown"}},
+			Right:    &UserProfile{Name: "Alice", Age: 30, Address: Address{Street: "1 Main St", City: "Anytown"}},
+			Expected: false,
+			Options:  AreEqualOptions{NumericCoercion: true, StructMapCoercion: true, NilEqualsEmpty: true},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&AreEqualData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Zero options are strict", testCaseIndex),
+			},
+			Left:     map[string]any{"a": float64(1), "b": nil},
+			Right:    map[string]any{"a": 1, "b": []any{}},
+			Expected: false,
+			Options:  AreEqualOptions{},
+		},
+	) {
+		return
+	}
 }
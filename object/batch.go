@@ -0,0 +1,157 @@
+package object
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/path"
+)
+
+/*
+Tx is a transaction on a working copy of `Object.source`. It is passed to the function given to Object.Batch.
+
+Its methods work like the Object methods with the same name but only modify the working copy.
+*/
+type Tx struct {
+	object *Object
+}
+
+// Get is Object.Get on the working copy.
+func (n *Tx) Get(jsonPath path.JSONPath) (any, uint64, error) {
+	return n.object.Get(jsonPath)
+}
+
+// GetAll is Object.GetAll on the working copy.
+func (n *Tx) GetAll(jsonPath path.JSONPath) ([]QueryResult, error) {
+	return n.object.GetAll(jsonPath)
+}
+
+// Set is Object.Set on the working copy.
+func (n *Tx) Set(jsonPath path.JSONPath, value any) (uint64, error) {
+	return n.object.Set(jsonPath, value)
+}
+
+// Delete is Object.Delete on the working copy.
+func (n *Tx) Delete(jsonPath path.JSONPath) (uint64, error) {
+	return n.object.Delete(jsonPath)
+}
+
+// Insert is Object.Insert on the working copy.
+func (n *Tx) Insert(jsonPath path.JSONPath, value any) (uint64, error) {
+	return n.object.Insert(jsonPath, value)
+}
+
+// Append is Object.Append on the working copy.
+func (n *Tx) Append(jsonPath path.JSONPath, values ...any) (uint64, error) {
+	return n.object.Append(jsonPath, values...)
+}
+
+// Update is Object.Update on the working copy.
+func (n *Tx) Update(jsonPath path.JSONPath, updateValue UpdateValueInObject) (uint64, uint64, error) {
+	return n.object.Update(jsonPath, updateValue)
+}
+
+// GetSourceInterface returns the working copy.
+func (n *Tx) GetSourceInterface() any {
+	return n.object.GetSourceInterface()
+}
+
+/*
+Batch runs batch with a Tx whose operations are applied to a deep copy of `Object.source`.
+
+If batch returns nil, the working copy replaces `Object.source`. Otherwise `Object.source` is left unchanged, even if some operations in batch succeeded,
+and the error returned by batch is nested in the error returned. Like ApplyPatch, `Object.source` is replaced rather than modified hence use
+Object.GetSourceInterface to get the result e.g., when the source is a pointer to a struct.
+
+Operations on the Tx do not stop batch when they fail. Check their errors and return one to roll back.
+
+Example:
+
+	err := obj.Batch(func(tx *object.Tx) error {
+		if _, err := tx.Set("$.status", "shipped"); err != nil {
+			return err
+		}
+		if _, err := tx.Delete("$.draft"); err != nil {
+			return err
+		}
+		return nil
+	})
+*/
+func (n *Object) Batch(batch func(tx *Tx) error) error {
+	const FunctionName = "Batch"
+
+	workingObject := n.workingCopy()
+	if err := batch(&Tx{object: workingObject}); err != nil {
+		return NewError().WithFunctionName(FunctionName).WithMessage("batch failed, source not modified").WithNestedError(err)
+	}
+
+	n.replaceSource(workingObject.source)
+	return nil
+}
+
+/*
+Snapshot is a deep copy of `Object.source` taken with Object.Snapshot.
+
+It can be restored any number of times with Object.Restore.
+*/
+type Snapshot struct {
+	source reflect.Value
+}
+
+// Snapshot returns a deep copy of `Object.source` which can later be restored with Object.Restore.
+func (n *Object) Snapshot() *Snapshot {
+	return &Snapshot{source: CloneReflect(n.source)}
+}
+
+/*
+Restore replaces `Object.source` with a deep copy of snapshot.
+
+Modifications to `Object.source` after Restore do not affect snapshot hence it can be restored again.
+*/
+func (n *Object) Restore(snapshot *Snapshot) {
+	if snapshot == nil {
+		return
+	}
+	n.replaceSource(CloneReflect(snapshot.source))
+}
+
+/*
+Undo replaces `Object.source` with the one it replaced during the last Object.Batch, Object.Restore, Object.ApplyPatch, or Object.MergePatch.
+
+Only the last `Object.undoDepth` replacements are kept. Modifications made with Set, Delete, and the other methods that modify `Object.source` in place
+cannot be undone on their own. Wrap them in Object.Batch to be able to undo them.
+
+Returns false if there is nothing to undo.
+*/
+func (n *Object) Undo() bool {
+	if len(n.undoStack) == 0 {
+		return false
+	}
+
+	n.source = n.undoStack[len(n.undoStack)-1]
+	n.undoStack[len(n.undoStack)-1] = reflect.Value{}
+	n.undoStack = n.undoStack[:len(n.undoStack)-1]
+	return true
+}
+
+// workingCopy returns an Object with the same configuration as n and a deep copy of `Object.source`.
+func (n *Object) workingCopy() *Object {
+	return &Object{
+		source:            CloneReflect(n.source),
+		sourceType:        n.sourceType,
+		schema:            n.schema,
+		defaultConverter:  n.defaultConverter,
+		fieldNameStrategy: n.fieldNameStrategy,
+	}
+}
+
+// replaceSource sets `Object.source` to source and adds the previous source to `Object.undoStack` if `Object.undoDepth` is greater than 0. The oldest source is dropped once the stack is full.
+func (n *Object) replaceSource(source reflect.Value) {
+	if n.undoDepth > 0 {
+		if len(n.undoStack) >= n.undoDepth {
+			n.undoStack = slices.Delete(n.undoStack, 0, len(n.undoStack)-n.undoDepth+1)
+		}
+		n.undoStack = append(n.undoStack, n.source)
+	}
+	n.source = source
+}
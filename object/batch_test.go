@@ -0,0 +1,164 @@
+package object
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+)
+
+func TestObject_Batch(t *testing.T) {
+	source := map[string]any{"status": "pending", "draft": true, "items": []any{"a"}}
+	obj := NewObject().WithSourceInterface(source)
+
+	err := obj.Batch(func(tx *Tx) error {
+		if _, err := tx.Set("$.status", "shipped"); err != nil {
+			return err
+		}
+		if _, err := tx.Delete("$.draft"); err != nil {
+			return err
+		}
+		if _, err := tx.Append("$.items", "b"); err != nil {
+			return err
+		}
+		if value, _, _ := tx.Get("$.status"); value != "shipped" {
+			t.Error("expected working copy to be modified, got=", value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error("expected batch to succeed, got err=", err)
+	}
+
+	expected := map[string]any{"status": "shipped", "items": []any{"a", "b"}}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+		t.Error("expected=", core.JsonStringifyMust(expected), "got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+	if source["status"] != "pending" {
+		t.Error("expected original source to not be modified, got=", source)
+	}
+
+	errBatch := errors.New("batch failed")
+	err = obj.Batch(func(tx *Tx) error {
+		if _, err := tx.Set("$.status", "cancelled"); err != nil {
+			return err
+		}
+		if tx.GetSourceInterface().(map[string]any)["status"] != "cancelled" {
+			t.Error("expected working copy to be modified")
+		}
+		return errBatch
+	})
+	if !errors.Is(err, errBatch) || !errors.Is(err, ErrObjectError) {
+		t.Error("expected error from batch, got err=", err)
+	}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+		t.Error("expected source to be rolled back, got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+}
+
+func TestObject_BatchStruct(t *testing.T) {
+	order := &inventory{Tags: []string{"a"}, Slots: [2]int{1, 2}}
+	obj := NewObject().WithSourceInterface(order)
+
+	err := obj.Batch(func(tx *Tx) error {
+		if _, err := tx.Set("$.Slots[1]", "5"); err != nil {
+			return err
+		}
+		if _, err := tx.Insert("$.Tags[0]", "z"); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error("expected batch to succeed, got err=", err)
+	}
+
+	expected := &inventory{Tags: []string{"z", "a"}, Slots: [2]int{1, 5}}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+		t.Error("expected=", core.JsonStringifyMust(expected), "got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+	if !reflect.DeepEqual(order, &inventory{Tags: []string{"a"}, Slots: [2]int{1, 2}}) {
+		t.Error("expected original struct to not be modified, got=", core.JsonStringifyMust(order))
+	}
+}
+
+func TestObject_SnapshotRestore(t *testing.T) {
+	obj := NewObject().WithSourceInterface(map[string]any{"user": map[string]any{"name": "Alice"}})
+
+	snapshot := obj.Snapshot()
+	if _, err := obj.Set("$.user.name", "Bob"); err != nil {
+		t.Error("expected set to succeed, got err=", err)
+	}
+
+	expected := map[string]any{"user": map[string]any{"name": "Alice"}}
+	for i := range 2 {
+		obj.Restore(snapshot)
+		if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+			t.Error("restore", i, "expected=", core.JsonStringifyMust(expected), "got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+		}
+		if _, err := obj.Set("$.user.name", "Carol"); err != nil {
+			t.Error("expected set to succeed, got err=", err)
+		}
+	}
+
+	obj.Restore(nil)
+	if value, _, _ := obj.Get("$.user.name"); value != "Carol" {
+		t.Error("expected nil snapshot to be ignored, got=", value)
+	}
+}
+
+func TestObject_Undo(t *testing.T) {
+	setStatus := func(obj *Object, status string) {
+		if err := obj.Batch(func(tx *Tx) error {
+			_, err := tx.Set("$.status", status)
+			return err
+		}); err != nil {
+			t.Error("expected batch to succeed, got err=", err)
+		}
+	}
+	status := func(obj *Object) any {
+		value, _, _ := obj.Get("$.status")
+		return value
+	}
+
+	obj := NewObject().WithSourceInterface(map[string]any{"status": "a"})
+	setStatus(obj, "b")
+	if obj.Undo() {
+		t.Error("expected undo to be disabled by default")
+	}
+
+	obj = NewObject().WithSourceInterface(map[string]any{"status": "a"}).WithUndoDepth(2)
+	setStatus(obj, "b")
+	setStatus(obj, "c")
+	setStatus(obj, "d")
+	if !obj.Undo() || status(obj) != "c" {
+		t.Error("expected=c got=", status(obj))
+	}
+	if !obj.Undo() || status(obj) != "b" {
+		t.Error("expected=b got=", status(obj))
+	}
+	if obj.Undo() || status(obj) != "b" {
+		t.Error("expected only the last 2 replacements to be kept, got=", status(obj))
+	}
+
+	if err := obj.ApplyPatch(Patch{{Op: PatchOpReplace, Path: "/status", Value: "e"}}); err != nil {
+		t.Error("expected patch to succeed, got err=", err)
+	}
+	if !obj.Undo() || status(obj) != "b" {
+		t.Error("expected patch to be undone, got=", status(obj))
+	}
+
+	setStatus(obj, "f")
+	setStatus(obj, "g")
+	obj.SetUndoDepth(1)
+	if !obj.Undo() || status(obj) != "f" || obj.Undo() {
+		t.Error("expected undo stack to be trimmed to 1, got=", status(obj))
+	}
+
+	setStatus(obj, "h")
+	obj.SetSourceInterface(map[string]any{"status": "i"})
+	if obj.Undo() {
+		t.Error("expected undo stack to be cleared when source is set")
+	}
+}
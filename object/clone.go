@@ -0,0 +1,226 @@
+package object
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+/*
+Cloner Define custom clone logic.
+
+Meant to be implemented by custom data types that cannot be cloned by copying their members e.g., types holding a mutex or a file handle.
+*/
+type Cloner interface {
+	// Clone returns a copy of value.
+	//
+	// Parameters:
+	//   - value - Value to copy.
+	//
+	// Returns a copy of value of the same type.
+	Clone(value any) any
+
+	CloneReflect(value reflect.Value) reflect.Value
+}
+
+/*
+Cloners Map of custom cloners.
+
+Intended to be used for custom clone logic of user-defined types like structs.
+*/
+type Cloners map[reflect.Type]Cloner
+
+/*
+Clone returns a deep copy of value using a DeepClone with the default options.
+
+Example:
+
+	orderCopy := object.Clone(order) // orderCopy is of the same type as order e.g., *Order
+*/
+func Clone[T any](value T) T {
+	var result T
+	if valueCopy := NewDeepClone().CloneReflect(reflect.ValueOf(&value).Elem()); valueCopy.IsValid() {
+		reflect.ValueOf(&result).Elem().Set(valueCopy)
+	}
+	return result
+}
+
+// CloneReflect returns a deep copy of value using a DeepClone with the default options.
+func CloneReflect(value reflect.Value) reflect.Value {
+	return NewDeepClone().CloneReflect(value)
+}
+
+/*
+Clone returns a deep copy of value. See DeepClone.CloneReflect.
+*/
+func (n *DeepClone) Clone(value any) any {
+	return valueFoundInterface(n.CloneReflect(reflect.ValueOf(value)))
+}
+
+/*
+CloneReflect returns a copy of value that does not share maps, slices, arrays, or pointers with value.
+
+Structs, map values, slices, arrays, pointers, and interfaces are copied recursively. Map keys, channels, and functions are copied as they are.
+
+A pointer, map, or slice found more than once in value is copied once hence the copies share memory the same way the originals do.
+This also means cycles e.g., a linked list whose last node points to the first, are copied without recursing forever.
+
+Unexported struct fields are copied as they are unless CloneOptions.IncludeUnexportedFields is set.
+
+Types in `DeepClone.customCloners` are copied with their Cloner.
+*/
+func (n *DeepClone) CloneReflect(value reflect.Value) reflect.Value {
+	return n.clone(value, make(map[cloneVisit]reflect.Value))
+}
+
+// cloneVisit identifies a pointer, map, or slice that has already been copied.
+type cloneVisit struct {
+	pointer unsafe.Pointer
+	length  int
+	typ     reflect.Type
+}
+
+// clone is the recursive implementation of CloneReflect. visited holds the copies of the pointers, maps, and slices copied so far.
+func (n *DeepClone) clone(value reflect.Value, visited map[cloneVisit]reflect.Value) reflect.Value {
+	if !value.IsValid() {
+		return value
+	}
+
+	if !value.CanInterface() {
+		// e.g., value was obtained through an unexported struct field.
+		if !value.CanAddr() {
+			return value
+		}
+		value = unexportedField(value)
+	}
+
+	if customCloner, ok := n.customCloners[value.Type()]; ok {
+		return customCloner.CloneReflect(value)
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		visit := cloneVisit{pointer: value.UnsafePointer(), typ: value.Type()}
+		if valueCopy, ok := visited[visit]; ok {
+			return valueCopy
+		}
+		valueCopy := reflect.New(value.Type().Elem())
+		visited[visit] = valueCopy
+		valueCopy.Elem().Set(n.clone(value.Elem(), visited))
+		return valueCopy
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		valueCopy := reflect.New(value.Type()).Elem()
+		valueCopy.Set(n.clone(value.Elem(), visited))
+		return valueCopy
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		visit := cloneVisit{pointer: value.UnsafePointer(), length: value.Len(), typ: value.Type()}
+		if valueCopy, ok := visited[visit]; ok {
+			return valueCopy
+		}
+		valueCopy := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		visited[visit] = valueCopy
+		for i := 0; i < value.Len(); i++ {
+			valueCopy.Index(i).Set(n.clone(value.Index(i), visited))
+		}
+		return valueCopy
+	case reflect.Array:
+		valueCopy := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			valueCopy.Index(i).Set(n.clone(value.Index(i), visited))
+		}
+		return valueCopy
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		visit := cloneVisit{pointer: value.UnsafePointer(), typ: value.Type()}
+		if valueCopy, ok := visited[visit]; ok {
+			return valueCopy
+		}
+		valueCopy := reflect.MakeMapWithSize(value.Type(), value.Len())
+		visited[visit] = valueCopy
+		iter := value.MapRange()
+		for iter.Next() {
+			valueCopy.SetMapIndex(iter.Key(), n.clone(iter.Value(), visited))
+		}
+		return valueCopy
+	case reflect.Struct:
+		valueCopy := reflect.New(value.Type()).Elem()
+		valueCopy.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			field := valueCopy.Field(i)
+			if field.CanSet() {
+				field.Set(n.clone(field, visited))
+			} else if n.options.IncludeUnexportedFields {
+				field = unexportedField(field)
+				field.Set(n.clone(field, visited))
+			}
+		}
+		return valueCopy
+	default:
+		if value.CanAddr() && value.CanInterface() {
+			valueCopy := reflect.New(value.Type()).Elem()
+			valueCopy.Set(value)
+			return valueCopy
+		}
+		return value
+	}
+}
+
+// unexportedField returns an addressable field that can be read and set even if it is unexported.
+func unexportedField(field reflect.Value) reflect.Value {
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+// WithOptions sets the options that change what is copied. See CloneOptions.
+func (n *DeepClone) WithOptions(value CloneOptions) *DeepClone {
+	n.SetOptions(value)
+	return n
+}
+
+func (n *DeepClone) SetOptions(value CloneOptions) {
+	n.options = value
+}
+
+func (n *DeepClone) WithCustomCloners(value Cloners) *DeepClone {
+	n.SetCustomCloners(value)
+	return n
+}
+
+func (n *DeepClone) SetCustomCloners(value Cloners) {
+	n.customCloners = value
+}
+
+func NewDeepClone() *DeepClone {
+	n := new(DeepClone)
+	return n
+}
+
+/*
+DeepClone makes deep copies of values.
+*/
+type DeepClone struct {
+	// Pass custom clone logic.
+	//
+	// Useful for user defined types like structs.
+	customCloners Cloners
+
+	// Set with WithOptions or SetOptions.
+	options CloneOptions
+}
+
+/*
+CloneOptions change what DeepClone copies. The zero value copies unexported struct fields as they are.
+*/
+type CloneOptions struct {
+	// Copy unexported struct fields recursively like exported ones. Otherwise, the copy shares the maps, slices, and pointers held by unexported fields.
+	IncludeUnexportedFields bool
+}
@@ -0,0 +1,138 @@
+package object
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+)
+
+type cloneNode struct {
+	Value int
+	Next  *cloneNode
+}
+
+type cloneCache struct {
+	Name    string
+	entries map[string]int
+	mutex   *sync.Mutex
+}
+
+// cloneCacheCloner gives each copy of a cloneCache its own mutex.
+type cloneCacheCloner struct{}
+
+func (n cloneCacheCloner) Clone(value any) any {
+	return n.CloneReflect(reflect.ValueOf(value)).Interface()
+}
+
+func (n cloneCacheCloner) CloneReflect(value reflect.Value) reflect.Value {
+	cache := value.Interface().(cloneCache)
+	return reflect.ValueOf(cloneCache{Name: cache.Name, entries: map[string]int{}, mutex: new(sync.Mutex)})
+}
+
+func TestClone(t *testing.T) {
+	zipCode := "12345"
+	original := &ComplexData{
+		ID:      1,
+		Details: map[string]any{"tags": []any{"a", map[string]any{"b": 1}}, "address": &Address{Street: "Main", ZipCode: &zipCode}},
+		Items: []struct {
+			Name  string
+			Value int
+		}{{"a", 1}},
+		User: User{Name: "Alice"},
+	}
+
+	cloned := Clone(original)
+	if !reflect.DeepEqual(cloned, original) {
+		t.Error("expected=", core.JsonStringifyMust(original), "got=", core.JsonStringifyMust(cloned))
+	}
+
+	cloned.Details["tags"].([]any)[1].(map[string]any)["b"] = 2
+	cloned.Items[0].Value = 2
+	*cloned.Details["address"].(*Address).ZipCode = "54321"
+	if original.Details["tags"].([]any)[1].(map[string]any)["b"] != 1 || original.Items[0].Value != 1 || zipCode != "12345" {
+		t.Error("expected original to not be modified, got=", core.JsonStringifyMust(original))
+	}
+
+	if Clone[any](nil) != nil || Clone[*ComplexData](nil) != nil {
+		t.Error("expected nil to be cloned as nil")
+	}
+	if cloned := NewDeepClone().Clone([2]int{1, 2}); cloned != [2]int{1, 2} {
+		t.Error("expected=[1 2] got=", cloned)
+	}
+}
+
+func TestClone_Aliasing(t *testing.T) {
+	shared := &Address{Street: "Main"}
+	tags := []string{"a", "b"}
+	original := map[string]any{
+		"home":    shared,
+		"work":    shared,
+		"tags":    tags,
+		"sameTag": tags,
+	}
+
+	cloned := Clone(original)
+	if cloned["home"] != cloned["work"] {
+		t.Error("expected shared pointer to remain shared")
+	}
+	if cloned["home"] == shared {
+		t.Error("expected shared pointer to be copied")
+	}
+
+	cloned["tags"].([]string)[0] = "z"
+	if cloned["sameTag"].([]string)[0] != "z" || tags[0] != "a" {
+		t.Error("expected shared slice to remain shared in the copy only, got=", cloned["sameTag"], tags)
+	}
+}
+
+func TestClone_Cycles(t *testing.T) {
+	first := &cloneNode{Value: 1}
+	first.Next = &cloneNode{Value: 2, Next: first}
+
+	cloned := Clone(first)
+	if cloned == first || cloned.Next == first.Next {
+		t.Error("expected nodes to be copied")
+	}
+	if cloned.Next.Next != cloned || cloned.Next.Value != 2 {
+		t.Error("expected cycle to be preserved")
+	}
+
+	selfReferencing := map[string]any{"name": "root"}
+	selfReferencing["self"] = selfReferencing
+	clonedMap := Clone(selfReferencing)
+	if reflect.ValueOf(clonedMap["self"]).UnsafePointer() != reflect.ValueOf(clonedMap).UnsafePointer() {
+		t.Error("expected map cycle to be preserved")
+	}
+	if reflect.ValueOf(clonedMap).UnsafePointer() == reflect.ValueOf(selfReferencing).UnsafePointer() {
+		t.Error("expected map to be copied")
+	}
+}
+
+func TestClone_UnexportedFields(t *testing.T) {
+	original := cloneCache{Name: "a", entries: map[string]int{"x": 1}}
+
+	cloned := Clone(original)
+	cloned.entries["x"] = 2
+	if original.entries["x"] != 2 {
+		t.Error("expected unexported fields to be copied as they are by default")
+	}
+
+	original.entries["x"] = 1
+	cloned = NewDeepClone().WithOptions(CloneOptions{IncludeUnexportedFields: true}).Clone(original).(cloneCache)
+	cloned.entries["x"] = 2
+	if original.entries["x"] != 1 || cloned.entries["x"] != 2 {
+		t.Error("expected unexported fields to be copied recursively, got=", original.entries, cloned.entries)
+	}
+}
+
+func TestClone_CustomCloners(t *testing.T) {
+	mutex := new(sync.Mutex)
+	original := map[string]cloneCache{"a": {Name: "a", entries: map[string]int{"x": 1}, mutex: mutex}}
+
+	cloned := NewDeepClone().WithCustomCloners(Cloners{reflect.TypeOf(cloneCache{}): cloneCacheCloner{}}).Clone(original).(map[string]cloneCache)
+	if cloned["a"].Name != "a" || cloned["a"].mutex == mutex || cloned["a"].mutex == nil || len(cloned["a"].entries) != 0 {
+		t.Error("expected custom cloner to be used, got=", cloned)
+	}
+}
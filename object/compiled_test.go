@@ -0,0 +1,134 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_Compiled(t *testing.T) {
+	for testData := range GetTestData {
+		compiledPath, err := path.Compile(testData.Path)
+		if err != nil {
+			// paths only supported by the lenient parser.
+			continue
+		}
+
+		obj := NewObject().WithSourceInterface(testData.Root)
+		valueFound, noOfResults, _ := obj.GetCompiled(compiledPath)
+		if noOfResults != testData.ExpectedOk || !reflect.DeepEqual(valueFound, testData.ExpectedValue) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected GetCompiled to be equal to Get\n",
+				"path=", testData.Path, "\n",
+				"ok=", noOfResults, "res=", core.JsonStringifyMust(valueFound), "\n",
+				"JSON testData.ExpectedValue=", core.JsonStringifyMust(testData.ExpectedValue),
+			)
+		}
+	}
+
+	for testData := range DeleteTestData {
+		compiledPath, err := path.Compile(testData.Path)
+		if err != nil {
+			continue
+		}
+
+		obj := NewObject().WithSourceInterface(testData.Root)
+		noOfResults, _ := obj.DeleteCompiled(compiledPath)
+		if noOfResults != testData.ExpectedOk || !reflect.DeepEqual(obj.GetSourceInterface(), testData.ExpectedValue) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected DeleteCompiled to be equal to Delete\n",
+				"path=", testData.Path, "\n",
+				"ok=", noOfResults, "res=", core.JsonStringifyMust(obj.GetSourceInterface()), "\n",
+				"JSON testData.ExpectedValue=", core.JsonStringifyMust(testData.ExpectedValue),
+			)
+		}
+	}
+}
+
+func TestObject_CompiledReuse(t *testing.T) {
+	compiledPath := path.MustCompile("$.orders[?@.total > 100].status")
+
+	for _, total := range []int{50, 150, 300} {
+		obj := NewObject().WithSourceInterface(map[string]any{"orders": []any{map[string]any{"total": total, "status": "open"}}})
+
+		noOfModifications, _ := obj.SetCompiled(compiledPath, "flagged")
+		results, _ := obj.GetAllCompiled(compiledPath)
+
+		expectedNoOfModifications := uint64(0)
+		if total > 100 {
+			expectedNoOfModifications = 1
+		}
+		if noOfModifications != expectedNoOfModifications || uint64(len(results)) != expectedNoOfModifications {
+			t.Error("total=", total, "\n", "expected modifications=", expectedNoOfModifications, "got=", noOfModifications, len(results))
+		}
+
+		noOfForEach := uint64(0)
+		obj.ForEachCompiled(compiledPath, func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+			if value.Interface() == "flagged" {
+				noOfForEach++
+			}
+			return false
+		})
+		if noOfForEach != expectedNoOfModifications {
+			t.Error("total=", total, "\n", "expected ForEachCompiled matches=", expectedNoOfModifications, "got=", noOfForEach)
+		}
+	}
+
+	if compiledPath.Segments().String() != "$.orders[?@.total > 100].status" {
+		t.Error("expected compiled path to be unchanged after use, got=", compiledPath.Segments())
+	}
+}
+
+func benchmarkObjectSource() map[string]any {
+	books := make([]any, 0)
+	for i := 0; i < 20; i++ {
+		books = append(books, map[string]any{"title": "Book", "price": float64(i)})
+	}
+	return map[string]any{"store": map[string]any{"book": books}}
+}
+
+func BenchmarkObject_Get(b *testing.B) {
+	obj := NewObject().WithSourceInterface(benchmarkObjectSource())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = obj.Get("$.store.book[5].title")
+	}
+}
+
+func BenchmarkObject_GetCompiled(b *testing.B) {
+	obj := NewObject().WithSourceInterface(benchmarkObjectSource())
+	compiledPath := path.MustCompile("$.store.book[5].title")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = obj.GetCompiled(compiledPath)
+	}
+}
+
+func BenchmarkObject_ForEach(b *testing.B) {
+	obj := NewObject().WithSourceInterface(benchmarkObjectSource())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		obj.ForEach("$.store.book[?@.price < 10].title", func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+			return false
+		})
+	}
+}
+
+func BenchmarkObject_ForEachCompiled(b *testing.B) {
+	obj := NewObject().WithSourceInterface(benchmarkObjectSource())
+	compiledPath := path.MustCompile("$.store.book[?@.price < 10].title")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		obj.ForEachCompiled(compiledPath, func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+			return false
+		})
+	}
+}
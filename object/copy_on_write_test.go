@@ -0,0 +1,249 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_CopyOnWrite(t *testing.T) {
+	for testData := range CopyOnWriteTestData {
+		original := CloneReflect(reflect.ValueOf(testData.Root)).Interface()
+		obj := NewObject().WithSourceInterface(testData.Root).WithCopyOnWrite(true)
+
+		noOfModifications, err := testData.Modify(obj)
+		if noOfModifications != testData.ExpectedNoOfModifications {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected noOfModifications=", testData.ExpectedNoOfModifications, "got=", noOfModifications, "\n",
+				"err=", err,
+			)
+		}
+
+		if !reflect.DeepEqual(testData.Root, original) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"original source modified\n",
+				"original=", core.JsonStringifyMust(original), "\n",
+				"got=", core.JsonStringifyMust(testData.Root),
+			)
+		}
+
+		if !reflect.DeepEqual(obj.GetSourceInterface(), testData.ExpectedValue) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"source not equal to testData.ExpectedValue\n",
+				"source=", core.JsonStringifyMust(obj.GetSourceInterface()), "\n",
+				"testData.ExpectedValue=", core.JsonStringifyMust(testData.ExpectedValue),
+			)
+		}
+	}
+}
+
+type CopyOnWriteData struct {
+	internal.TestData
+	Root                      any
+	Modify                    func(obj *Object) (uint64, error)
+	ExpectedNoOfModifications uint64
+	ExpectedValue             any
+}
+
+func CopyOnWriteTestData(yield func(data *CopyOnWriteData) bool) {
+	set := func(jsonPath path.JSONPath, value any) func(obj *Object) (uint64, error) {
+		return func(obj *Object) (uint64, error) {
+			return obj.Set(jsonPath, value)
+		}
+	}
+	del := func(jsonPath path.JSONPath) func(obj *Object) (uint64, error) {
+		return func(obj *Object) (uint64, error) {
+			return obj.Delete(jsonPath)
+		}
+	}
+
+	testCaseIndex := 1
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set nested map value", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": map[string]any{"b": map[string]any{"c": 1}}, "d": []any{1}},
+			Modify:                    set("$.a.b.c", 2),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             map[string]any{"a": map[string]any{"b": map[string]any{"c": 2}}, "d": []any{1}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set new nested map value", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": map[string]any{}},
+			Modify:                    set("$.a.b.c", 1),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             map[string]any{"a": map[string]any{"b": map[string]any{"c": 1}}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set slice elements with wildcard", testCaseIndex),
+			},
+			Root:                      map[string]any{"users": []any{map[string]any{"active": false}, map[string]any{"active": false}}},
+			Modify:                    set("$.users[*].active", true),
+			ExpectedNoOfModifications: 2,
+			ExpectedValue:             map[string]any{"users": []any{map[string]any{"active": true}, map[string]any{"active": true}}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set field of struct pointer in slice", testCaseIndex),
+			},
+			Root:                      &inventory{Profiles: []*UserProfile{{Name: "Alice", Address: Address{City: "Nairobi"}}}},
+			Modify:                    set("$.Profiles[0].Address.City", "Mombasa"),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             &inventory{Profiles: []*UserProfile{{Name: "Alice", Address: Address{City: "Mombasa"}}}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set array element of struct pointer", testCaseIndex),
+			},
+			Root:                      &inventory{Slots: [2]int{1, 2}},
+			Modify:                    set("$.Slots[1]", 3),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             &inventory{Slots: [2]int{1, 3}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with recursive descent", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": map[string]any{"id": 1}, "b": []any{map[string]any{"id": 2}}},
+			Modify:                    set("$..id", 0),
+			ExpectedNoOfModifications: 2,
+			ExpectedValue:             map[string]any{"a": map[string]any{"id": 0}, "b": []any{map[string]any{"id": 0}}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete nested map entry", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": map[string]any{"b": 1, "c": 2}},
+			Modify:                    del("$.a.b"),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             map[string]any{"a": map[string]any{"c": 2}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete slice element", testCaseIndex),
+			},
+			Root:                      map[string]any{"items": []any{1, 2, 3}},
+			Modify:                    del("$.items[1]"),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             map[string]any{"items": []any{1, 3}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete from slice of struct pointer", testCaseIndex),
+			},
+			Root:                      &inventory{Tags: []string{"a", "b"}},
+			Modify:                    del("$.Tags[0]"),
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             &inventory{Tags: []string{"b"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&CopyOnWriteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Insert into nested slice", testCaseIndex),
+			},
+			Root: map[string]any{"items": []any{"a", "c"}},
+			Modify: func(obj *Object) (uint64, error) {
+				return obj.Insert("$.items[1]", "b")
+			},
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             map[string]any{"items": []any{"a", "b", "c"}},
+		},
+	) {
+		return
+	}
+}
+
+func TestObject_CopyOnWriteSharing(t *testing.T) {
+	untouched := map[string]any{"x": 1}
+	source := map[string]any{
+		"modified":  map[string]any{"value": 1},
+		"untouched": untouched,
+	}
+	obj := NewObject().WithSourceInterface(source).WithCopyOnWrite(true)
+
+	if _, err := obj.Set("$.modified.value", 2); err != nil {
+		t.Error("expected set to succeed, got err=", err)
+	}
+	first := obj.GetSourceInterface().(map[string]any)
+	if reflect.ValueOf(first["untouched"]).UnsafePointer() != reflect.ValueOf(untouched).UnsafePointer() {
+		t.Error("expected untouched subtree to be shared")
+	}
+	if reflect.ValueOf(first).UnsafePointer() == reflect.ValueOf(source).UnsafePointer() {
+		t.Error("expected new root")
+	}
+
+	if noOfModifications, _ := obj.Delete("$.untouched.missing"); noOfModifications != 0 || reflect.ValueOf(obj.GetSourceInterface()).UnsafePointer() != reflect.ValueOf(first).UnsafePointer() {
+		t.Error("expected root to be kept when nothing is modified, got noOfModifications=", noOfModifications)
+	}
+
+	if _, err := obj.Set("$.modified.value", 3); err != nil {
+		t.Error("expected set to succeed, got err=", err)
+	}
+	if first["modified"].(map[string]any)["value"] != 2 || source["modified"].(map[string]any)["value"] != 1 {
+		t.Error("expected earlier roots to remain unchanged, got=", core.JsonStringifyMust(first), core.JsonStringifyMust(source))
+	}
+}
@@ -1,11 +1,14 @@
 package object
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
 )
 
 var (
@@ -17,6 +20,12 @@ var (
 
 	//ErrValueAtPathSegmentInvalidError for when a value at a path segment is not found or not expected.
 	ErrValueAtPathSegmentInvalidError = errors.New("value at path segment invalid")
+
+	//ErrPatchOperationError for when an operation in a JSON Patch document cannot be applied.
+	ErrPatchOperationError = errors.New("patch operation failed")
+
+	//ErrPatchTestFailedError for when the value at the path of a JSON Patch test operation is not equal to the value of the operation.
+	ErrPatchTestFailedError = errors.New("patch test failed")
 )
 
 // NewError creates a new core.Error with the default base error ErrObjectError.
@@ -25,6 +34,45 @@ func NewError() *core.Error {
 	return n
 }
 
+/*
+parsePath parses jsonPath like path.JSONPath.Parse.
+
+Unlike path.JSONPath.Parse, which returns no segments for an invalid JSON Pointer, it returns an error with ErrPathSegmentInvalidError
+and the error of path.JSONPointer.Parse if jsonPath starts with `/` and is not a valid JSON Pointer e.g., `/a~2`.
+*/
+func parsePath(jsonPath path.JSONPath) (path.RecursiveDescentSegments, error) {
+	const FunctionName = "parsePath"
+
+	if !strings.HasPrefix(string(jsonPath), path.JsonPointerSeparator) {
+		return jsonPath.Parse(), nil
+	}
+
+	segment, err := path.JSONPointer(jsonPath).Parse()
+	if err != nil {
+		return nil, NewError().WithFunctionName(FunctionName).WithMessage("invalid json pointer").WithNestedError(errors.Join(ErrPathSegmentInvalidError, err)).WithData(core.JsonObject{"JSONPath": jsonPath})
+	}
+	return path.RecursiveDescentSegments{segment}, nil
+}
+
+/*
+parseStrictPath parses jsonPath with path.JSONPath.ParseStrict or, if jsonPath starts with `/`, with path.JSONPointer.Parse.
+
+Unlike parsePath, it does not fall back to the lenient parser. It returns an error with ErrPathSegmentInvalidError if jsonPath is malformed e.g., `$.[[[`.
+*/
+func parseStrictPath(jsonPath path.JSONPath) (path.RecursiveDescentSegments, error) {
+	const FunctionName = "parseStrictPath"
+
+	if strings.HasPrefix(string(jsonPath), path.JsonPointerSeparator) {
+		return parsePath(jsonPath)
+	}
+
+	recursiveDescentSegments, err := jsonPath.ParseStrict()
+	if err != nil {
+		return nil, NewError().WithFunctionName(FunctionName).WithMessage("invalid jsonpath").WithNestedError(errors.Join(ErrPathSegmentInvalidError, err)).WithData(core.JsonObject{"JSONPath": jsonPath})
+	}
+	return recursiveDescentSegments, nil
+}
+
 // mapKeyString returns the string representation of a map key.
 // If the key is already a string, it returns it directly.
 // Otherwise, it uses JSON stringification to ensure a consistent string representation.
@@ -34,3 +82,47 @@ func mapKeyString(mapKey reflect.Value) string {
 	}
 	return fmt.Sprintf("%v", core.JsonStringifyMust(mapKey.Interface()))
 }
+
+// valueFoundInterface returns valueFound in Go form or nil if valueFound is not valid.
+func valueFoundInterface(valueFound reflect.Value) any {
+	if valueFound.IsValid() {
+		return valueFound.Interface()
+	}
+	return nil
+}
+
+// isRootPath returns true if recursiveDescentSegments only refers to the root `$`.
+func isRootPath(recursiveDescentSegments path.RecursiveDescentSegments) bool {
+	return len(recursiveDescentSegments) == 1 && len(recursiveDescentSegments[0]) == 1 && recursiveDescentSegments[0][0] != nil && recursiveDescentSegments[0][0].IsKeyRoot
+}
+
+// normalizeUnionIndexes returns unionSelector with negative indexes converted to positions in a linear collection of the given length. Indexes that are out of range are left out.
+func normalizeUnionIndexes(unionSelector path.RecursiveDescentSegment, length int) path.RecursiveDescentSegment {
+	normalized := make(path.RecursiveDescentSegment, 0, len(unionSelector))
+	for _, unionKey := range unionSelector {
+		if !unionKey.IsIndex {
+			normalized = append(normalized, unionKey)
+			continue
+		}
+		if index, ok := path.NormalizeIndex(unionKey.Index, length); ok {
+			normalized = append(normalized, &path.CollectionMemberSegment{IsIndex: true, Index: index})
+		}
+	}
+	return normalized
+}
+
+// compareConcretePaths orders paths made up of the root, keys, and indexes. Indexes are compared as numbers and a path comes before its descendants.
+func compareConcretePaths(a path.RecursiveDescentSegment, b path.RecursiveDescentSegment) int {
+	for i := 0; i < min(len(a), len(b)); i++ {
+		if a[i].IsIndex && b[i].IsIndex {
+			if c := cmp.Compare(a[i].Index, b[i].Index); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := cmp.Compare(a[i].Key, b[i].Key); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
@@ -20,47 +20,63 @@ Parameters:
 Returns the number of modifications made through deletion and the last error encountered.
 */
 func (n *Object) Delete(jsonPath path.JSONPath) (uint64, error) {
-	const FunctionName = "Delete"
+	if jsonPath == "" {
+		return n.delete(path.RecursiveDescentSegments{{{Key: path.JsonpathKeyRoot, IsKeyRoot: true}}})
+	}
+
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, err
+	}
+	return n.delete(recursiveDescentSegments)
+}
 
-	n.noOfResults = 0
-	n.lastError = nil
+// DeleteCompiled is like Delete but uses a path that has already been compiled with path.Compile.
+func (n *Object) DeleteCompiled(compiledPath *path.CompiledPath) (uint64, error) {
+	return n.delete(compiledPath.Segments())
+}
+
+// delete is the underlying implementation of Delete that works with an already parsed path.
+func (n *Object) delete(recursiveDescentSegments path.RecursiveDescentSegments) (uint64, error) {
+	const FunctionName = "Delete"
 
-	if jsonPath == "$" || jsonPath == "" {
+	if isRootPath(recursiveDescentSegments) {
 		n.source = reflect.Zero(n.source.Type())
 		return 1, nil
 	}
 
-	n.recursiveDescentSegments = jsonPath.Parse()
-
 	currentPathSegmentIndexes := internal.PathSegmentsIndexes{
 		CurrentRecursive: 0,
-		LastRecursive:    len(n.recursiveDescentSegments) - 1,
+		LastRecursive:    len(recursiveDescentSegments) - 1,
 	}
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive {
-		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.source.Interface()})
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
 	}
 	currentPathSegmentIndexes.CurrentCollection = 0
-	currentPathSegmentIndexes.LastCollection = len(n.recursiveDescentSegments[0]) - 1
+	currentPathSegmentIndexes.LastCollection = len(recursiveDescentSegments[0]) - 1
 	if currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
-		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.source.Interface()})
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
 	}
 
+	t := n.newTraversal(recursiveDescentSegments)
 	if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-		n.source = n.recursiveDelete(n.source, currentPathSegmentIndexes, make(path.RecursiveDescentSegment, 0))
+		n.source = t.recursiveDelete(n.source, currentPathSegmentIndexes, make(path.RecursiveDescentSegment, 0))
 	} else {
-		n.source = n.recursiveDescentDelete(n.source, currentPathSegmentIndexes, make(path.RecursiveDescentSegment, 0))
+		n.source = t.recursiveDescentDelete(n.source, currentPathSegmentIndexes, make(path.RecursiveDescentSegment, 0))
 	}
 
-	if n.noOfResults > 0 {
-		return n.noOfResults, nil
-	}
-	return n.noOfResults, n.lastError
+	return t.result()
 }
 
 // recursiveDelete traverses the object to find and remove the target value.
-func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+func (n *traversal) recursiveDelete(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) (modifiedValue reflect.Value) {
 	const FunctionName = "recursiveDelete"
 
+	if n.copyOnWrite {
+		defer n.keepIfUnmodified(currentValue, n.noOfResults, &modifiedValue)
+		currentValue = shallowCopy(currentValue)
+	}
+
 	recursiveSegment := n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]
 	if recursiveSegment == nil {
 		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage("recursiveSegment is nil").
@@ -118,6 +134,13 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 		return currentValue
 	}
 
+	if hasFilterSelector(recursiveSegment) {
+		var ok bool
+		if recursiveSegment, ok = n.resolveFilterSelector(currentValue, recursiveSegment, currentPath); !ok {
+			return currentValue
+		}
+	}
+
 	if mapKeyType, _, ok := core.GetMapKeyValueType(currentValue); ok {
 		if recursiveSegment.IsKey {
 			mapKey := reflect.New(mapKeyType).Elem()
@@ -247,11 +270,12 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 		}
 	} else if arraySliceType, ok := core.GetArraySliceValueType(currentValue); ok {
 		if recursiveSegment.IsIndex {
-			if recursiveSegment.Index >= currentValue.Len() {
+			if index, ok := path.NormalizeIndex(recursiveSegment.Index, currentValue.Len()); !ok {
 				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in linear collection, index %s out of range", recursiveSegment)).
 					WithNestedError(ErrValueAtPathSegmentInvalidError).
 					WithData(core.JsonObject{"CurrentValue": currentValue, "CurrentPathSegment": currentPath})
 			} else {
+				recursiveSegment = &path.CollectionMemberSegment{IsIndex: true, Index: index}
 				arraySliceValue := currentValue.Index(recursiveSegment.Index)
 				if arraySliceValue.IsValid() && arraySliceValue.CanSet() {
 					if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
@@ -335,6 +359,7 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 				}
 			}
 		} else if len(recursiveSegment.UnionSelector) > 0 {
+			recursiveSegment = &path.CollectionMemberSegment{UnionSelector: normalizeUnionIndexes(recursiveSegment.UnionSelector, currentValue.Len())}
 			if currentValue.Kind() == reflect.Array || (currentPathSegmentIndexes.CurrentCollection != currentPathSegmentIndexes.LastCollection || currentPathSegmentIndexes.CurrentRecursive != currentPathSegmentIndexes.LastRecursive) {
 				for _, unionKey := range recursiveSegment.UnionSelector {
 					if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() {
@@ -378,7 +403,7 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 			} else if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection && currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 				indexesToExclude := make([]int, 0)
 				for _, unionKey := range recursiveSegment.UnionSelector {
-					if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() {
+					if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() || slices.Contains(indexesToExclude, unionKey.Index) {
 						continue
 					}
 					indexesToExclude = append(indexesToExclude, unionKey.Index)
@@ -393,6 +418,7 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 					newSlice.Index(i - skip).Set(currentValue.Index(i))
 				}
 				currentValue = newSlice
+				n.noOfResults += uint64(len(indexesToExclude))
 			} else {
 				for _, unionKey := range recursiveSegment.UnionSelector {
 					if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() {
@@ -429,118 +455,53 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 				}
 			}
 		} else if recursiveSegment.LinearCollectionSelector != nil {
-			start := 0
-			if recursiveSegment.LinearCollectionSelector.IsStart {
-				start = recursiveSegment.LinearCollectionSelector.Start
-			}
-			step := 1
-			if recursiveSegment.LinearCollectionSelector.IsStep && recursiveSegment.LinearCollectionSelector.Step > 0 {
-				step = recursiveSegment.LinearCollectionSelector.Step
-			}
-			end := currentValue.Len()
-			if recursiveSegment.LinearCollectionSelector.IsEnd {
-				end = recursiveSegment.LinearCollectionSelector.End
-			}
-
-			if end > currentValue.Len() {
-				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in linear collection, linear collection selector %s End is out of range", recursiveSegment)).
-					WithNestedError(ErrPathSegmentInvalidError).
-					WithData(core.JsonObject{"CurrentValue": currentValue, "CurrentPathSegment": currentPath})
-			} else {
-				if currentValue.Kind() == reflect.Array || (currentPathSegmentIndexes.CurrentCollection != currentPathSegmentIndexes.LastCollection || currentPathSegmentIndexes.CurrentRecursive != currentPathSegmentIndexes.LastRecursive) {
-					for i := start; i < end; i += step {
-						if i >= currentValue.Len() {
-							continue
-						}
-
-						valueFromSliceArray := currentValue.Index(i)
-						if !valueFromSliceArray.CanSet() || !valueFromSliceArray.IsValid() {
-							continue
-						}
-
-						if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
-							if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-								valueFromSliceArray.Set(reflect.Zero(arraySliceType))
-								n.noOfResults++
-								continue
-							}
-
-							recursiveDescentIndexes := internal.PathSegmentsIndexes{
-								CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
-								LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-								CurrentCollection: 0,
-								LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
-							}
+			indices := recursiveSegment.LinearCollectionSelector.Indices(currentValue.Len())
+			if currentValue.Kind() == reflect.Array || (currentPathSegmentIndexes.CurrentCollection != currentPathSegmentIndexes.LastCollection || currentPathSegmentIndexes.CurrentRecursive != currentPathSegmentIndexes.LastRecursive) {
+				for _, i := range indices {
+					valueFromSliceArray := currentValue.Index(i)
+					if !valueFromSliceArray.CanSet() || !valueFromSliceArray.IsValid() {
+						continue
+					}
 
-							recursiveDescentValue := n.recursiveDescentDelete(valueFromSliceArray, recursiveDescentIndexes, append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: i}))
-							valueFromSliceArray.Set(recursiveDescentValue)
+					if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
+						if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
+							valueFromSliceArray.Set(reflect.Zero(arraySliceType))
+							n.noOfResults++
 							continue
 						}
 
-						recursiveIndexes := internal.PathSegmentsIndexes{
-							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+						recursiveDescentIndexes := internal.PathSegmentsIndexes{
+							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
 							LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-							CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
-							LastCollection:    currentPathSegmentIndexes.LastCollection,
+							CurrentCollection: 0,
+							LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 						}
 
-						recursiveValue := n.recursiveDelete(valueFromSliceArray, recursiveIndexes, append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: i}))
-						valueFromSliceArray.Set(recursiveValue)
+						recursiveDescentValue := n.recursiveDescentDelete(valueFromSliceArray, recursiveDescentIndexes, append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: i}))
+						valueFromSliceArray.Set(recursiveDescentValue)
+						continue
 					}
-				} else if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection && currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-					indexesToExclude := make([]int, 0)
-					for i := start; i < end; i += step {
-						if i >= currentValue.Len() {
-							continue
-						}
 
-						indexesToExclude = append(indexesToExclude, i)
-					}
-					newSlice := reflect.MakeSlice(currentValue.Type(), currentValue.Len()-len(indexesToExclude), currentValue.Len()-len(indexesToExclude))
-					skip := 0
-					for i := 0; i < currentValue.Len(); i++ {
-						if slices.Contains(indexesToExclude, i) {
-							skip++
-							continue
-						}
-						newSlice.Index(i - skip).Set(currentValue.Index(i))
+					recursiveIndexes := internal.PathSegmentsIndexes{
+						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+						LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+						CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
+						LastCollection:    currentPathSegmentIndexes.LastCollection,
 					}
-					currentValue = newSlice
-				} else {
-					for i := start; i < end; i += step {
-						if i >= currentValue.Len() {
-							continue
-						}
 
-						arraySliceValue := currentValue.Index(i)
-						if !arraySliceValue.CanSet() || !arraySliceValue.IsValid() {
-							continue
-						}
-
-						if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
-							recursiveDescentIndexes := internal.PathSegmentsIndexes{
-								CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
-								LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-								CurrentCollection: 0,
-								LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
-							}
-
-							recursiveDescentValue := n.recursiveDescentDelete(arraySliceValue, recursiveDescentIndexes, append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: i}))
-							arraySliceValue.Set(recursiveDescentValue)
-							continue
-						}
-
-						recursiveIndexes := internal.PathSegmentsIndexes{
-							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
-							LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-							CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
-							LastCollection:    currentPathSegmentIndexes.LastCollection,
-						}
-
-						recursiveValue := n.recursiveDelete(arraySliceValue, recursiveIndexes, append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: i}))
-						arraySliceValue.Set(recursiveValue)
+					recursiveValue := n.recursiveDelete(valueFromSliceArray, recursiveIndexes, append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: i}))
+					valueFromSliceArray.Set(recursiveValue)
+				}
+			} else {
+				newSlice := reflect.MakeSlice(currentValue.Type(), 0, currentValue.Len()-len(indices))
+				for i := 0; i < currentValue.Len(); i++ {
+					if slices.Contains(indices, i) {
+						continue
 					}
+					newSlice = reflect.Append(newSlice, currentValue.Index(i))
 				}
+				currentValue = newSlice
+				n.noOfResults += uint64(len(indices))
 			}
 		} else {
 			n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in linear collection, unsupported recursive segment %s", recursiveSegment)).
@@ -548,13 +509,13 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 				WithData(core.JsonObject{"CurrentValue": currentValue, "CurrentPathSegment": currentPath})
 		}
 	} else if currentValue.Kind() == reflect.Struct {
+		currentValue = settableCopy(currentValue)
 		if recursiveSegment.IsKey {
-			if !core.StartsWithCapital(recursiveSegment.Key) {
-				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("key %v is not valid for struct", recursiveSegment)).
+			if structFieldValue, _, ok := n.structField(currentValue, recursiveSegment.Key); !ok {
+				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(n.invalidStructFieldMessage(currentValue, recursiveSegment)).
 					WithNestedError(ErrPathSegmentInvalidError).
 					WithData(core.JsonObject{"CurrentValue": currentValue, "CurrentPathSegment": currentPath})
 			} else {
-				structFieldValue := currentValue.FieldByName(recursiveSegment.Key)
 				if structFieldValue.IsValid() && structFieldValue.CanSet() {
 					if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 						if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
@@ -585,8 +546,8 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 				}
 			}
 		} else if recursiveSegment.IsKeyIndexAll {
-			for i := 0; i < currentValue.NumField(); i++ {
-				structField := currentValue.Field(i)
+			for _, structFieldName := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+				structField := structFieldName.Value(currentValue)
 
 				if !structField.CanSet() {
 					continue
@@ -606,7 +567,7 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 						LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 					}
 
-					recursiveDescentValue := n.recursiveDescentDelete(structField, recursiveDescentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name}))
+					recursiveDescentValue := n.recursiveDescentDelete(structField, recursiveDescentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structFieldName.Name}))
 					structField.Set(recursiveDescentValue)
 					continue
 				}
@@ -618,17 +579,17 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 					LastCollection:    currentPathSegmentIndexes.LastCollection,
 				}
 
-				recursiveValue := n.recursiveDelete(structField, recursiveIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name}))
+				recursiveValue := n.recursiveDelete(structField, recursiveIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structFieldName.Name}))
 				structField.Set(recursiveValue)
 			}
 		} else if len(recursiveSegment.UnionSelector) > 0 {
 			for _, unionKey := range recursiveSegment.UnionSelector {
-				if !unionKey.IsKey || !core.StartsWithCapital(unionKey.Key) {
+				if !unionKey.IsKey {
 					continue
 				}
 
-				structFieldValue := currentValue.FieldByName(unionKey.Key)
-				if !structFieldValue.CanSet() {
+				structFieldValue, _, ok := n.structField(currentValue, unionKey.Key)
+				if !ok || !structFieldValue.CanSet() {
 					continue
 				}
 
@@ -676,9 +637,14 @@ func (n *Object) recursiveDelete(currentValue reflect.Value, currentPathSegmentI
 }
 
 // recursiveDescentDelete handles deletion when the path involves recursive descent ('..').
-func (n *Object) recursiveDescentDelete(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+func (n *traversal) recursiveDescentDelete(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) (modifiedValue reflect.Value) {
 	const FunctionName = "recursiveDescentDelete"
 
+	if n.copyOnWrite {
+		defer n.keepIfUnmodified(currentValue, n.noOfResults, &modifiedValue)
+		currentValue = shallowCopy(currentValue)
+	}
+
 	recursiveDescentSearchSegment := n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]
 	if recursiveDescentSearchSegment == nil {
 		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage("recursive descent search segment is nil").
@@ -705,13 +671,6 @@ func (n *Object) recursiveDescentDelete(currentValue reflect.Value, currentPathS
 		return n.recursiveDelete(currentValue, currentPathSegmentIndexes, currentPath)
 	}
 
-	if !recursiveDescentSearchSegment.IsKey {
-		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("recursive descent search segment %s is not key", recursiveDescentSearchSegment)).
-			WithNestedError(ErrPathSegmentInvalidError).
-			WithData(core.JsonObject{"CurrentValue": currentValue, "CurrentPathSegment": currentPath})
-		return currentValue
-	}
-
 	if currentValue.Kind() == reflect.Interface {
 		return n.recursiveDescentDelete(currentValue.Elem(), currentPathSegmentIndexes, currentPath)
 	}
@@ -722,6 +681,10 @@ func (n *Object) recursiveDescentDelete(currentValue reflect.Value, currentPathS
 		return currentValue
 	}
 
+	if !recursiveDescentSearchSegment.IsKey {
+		return n.recursiveDescentSelectorDelete(currentValue, currentPathSegmentIndexes, currentPath)
+	}
+
 	if _, _, ok := core.GetMapKeyValueType(currentValue); ok {
 		for _, mapKey := range currentValue.MapKeys() {
 			mapValue := currentValue.MapIndex(mapKey)
@@ -779,49 +742,44 @@ func (n *Object) recursiveDescentDelete(currentValue reflect.Value, currentPathS
 			}
 		}
 	} else if currentValue.Kind() == reflect.Struct {
-		if core.StartsWithCapital(recursiveDescentSearchSegment.Key) {
-			if structFieldValue := currentValue.FieldByName(recursiveDescentSearchSegment.Key); structFieldValue.IsValid() && structFieldValue.CanSet() {
-				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
-					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						structFieldValue.Set(reflect.Zero(structFieldValue.Type()))
-						n.noOfResults++
-					} else {
-						recursiveDescentIndexes := internal.PathSegmentsIndexes{
-							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
-							LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-							CurrentCollection: 0,
-							LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
-						}
-
-						recursiveDescentValue := n.recursiveDescentDelete(structFieldValue, recursiveDescentIndexes, append(currentPath, recursiveDescentSearchSegment))
-						structFieldValue.Set(recursiveDescentValue)
-					}
+		currentValue = settableCopy(currentValue)
+		if structFieldValue, _, ok := n.structField(currentValue, recursiveDescentSearchSegment.Key); ok && structFieldValue.IsValid() && structFieldValue.CanSet() {
+			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
+				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
+					structFieldValue.Set(reflect.Zero(structFieldValue.Type()))
+					n.noOfResults++
 				} else {
-					recursiveIndexes := internal.PathSegmentsIndexes{
-						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					recursiveDescentIndexes := internal.PathSegmentsIndexes{
+						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
 						LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-						CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
-						LastCollection:    currentPathSegmentIndexes.LastCollection,
+						CurrentCollection: 0,
+						LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 					}
 
-					recursiveValue := n.recursiveDelete(structFieldValue, recursiveIndexes, append(currentPath, recursiveDescentSearchSegment))
-					structFieldValue.Set(recursiveValue)
+					recursiveDescentValue := n.recursiveDescentDelete(structFieldValue, recursiveDescentIndexes, append(currentPath, recursiveDescentSearchSegment))
+					structFieldValue.Set(recursiveDescentValue)
+				}
+			} else {
+				recursiveIndexes := internal.PathSegmentsIndexes{
+					CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+					CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
+					LastCollection:    currentPathSegmentIndexes.LastCollection,
 				}
-			}
-		}
 
-		for i := 0; i < currentValue.NumField(); i++ {
-			if !core.IsStructFieldExported(currentValue.Type().Field(i)) {
-				continue
+				recursiveValue := n.recursiveDelete(structFieldValue, recursiveIndexes, append(currentPath, recursiveDescentSearchSegment))
+				structFieldValue.Set(recursiveValue)
 			}
+		}
 
-			structFieldValue := currentValue.Field(i)
+		for _, structField := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+			structFieldValue := structField.Value(currentValue)
 			if !structFieldValue.IsValid() {
 				continue
 			}
 
 			if structFieldValue.CanSet() {
-				recursiveDescentValue := n.recursiveDescentDelete(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name}))
+				recursiveDescentValue := n.recursiveDescentDelete(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structField.Name}))
 				structFieldValue.Set(recursiveDescentValue)
 			}
 		}
@@ -832,3 +790,25 @@ func (n *Object) recursiveDescentDelete(currentValue reflect.Value, currentPathS
 	}
 	return currentValue
 }
+
+/*
+recursiveDescentSelectorDelete handles a recursive descent search segment that is not a key e.g., `$..[?@.price < 10]` or `$..[0]`.
+
+The descendants of currentValue are processed first before the selector is applied to currentValue itself.
+*/
+func (n *traversal) recursiveDescentSelectorDelete(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+	for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+		recursiveDescentValue := n.recursiveDescentDelete(member.value, currentPathSegmentIndexes, append(currentPath, member.segment))
+		if member.mapKey.IsValid() {
+			currentValue.SetMapIndex(member.mapKey, recursiveDescentValue)
+		} else if member.value.CanSet() {
+			member.value.Set(recursiveDescentValue)
+		}
+	}
+
+	if !isCollection(currentValue) {
+		return currentValue
+	}
+
+	return n.recursiveDelete(currentValue, currentPathSegmentIndexes, currentPath)
+}
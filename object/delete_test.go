@@ -542,4 +542,132 @@ func DeleteTestData(yield func(data *DeleteData) bool) {
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete with filter selector", testCaseIndex),
+			},
+			Root: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 1, "status": "open", "total": 150},
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+					map[string]any{"id": 4, "status": "open", "total": 120.5},
+				},
+			},
+			Path:       "$.orders[?(@.status == 'open' && @.total > 100)]",
+			ExpectedOk: 2,
+			ExpectedValue: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+				},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete map entries with filter selector in recursive descent", testCaseIndex),
+			},
+			Root: map[string]any{
+				"a": map[string]any{"keep": true},
+				"b": map[string]any{
+					"c": map[string]any{"keep": false},
+					"d": map[string]any{"keep": true},
+				},
+			},
+			Path:       "$..[?@.keep == false]",
+			ExpectedOk: 1,
+			ExpectedValue: map[string]any{
+				"a": map[string]any{"keep": true},
+				"b": map[string]any{
+					"d": map[string]any{"keep": true},
+				},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete last element with a negative index", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a", "b", "c"}},
+			Path:          "$.items[-1]",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"items": []any{"a", "b"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete with negative step slice", testCaseIndex),
+			},
+			Root:          []int{1, 2, 3, 4, 5},
+			Path:          "$[::-2]",
+			ExpectedOk:    3,
+			ExpectedValue: []int{2, 4},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete in fixed-size array with negative indexes", testCaseIndex),
+			},
+			Root:          &[4]int{1, 2, 3, 4},
+			Path:          "$[-1,-2]",
+			ExpectedOk:    2,
+			ExpectedValue: &[4]int{1, 2, 0, 0},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete with JSON Pointer", testCaseIndex),
+			},
+			Root:          map[string]any{"data": map[string]any{"items": []any{1, 2, 3}, "m~n": true}},
+			Path:          "/data/items/1",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"data": map[string]any{"items": []any{1, 3}, "m~n": true}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DeleteData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete map key with JSON Pointer", testCaseIndex),
+			},
+			Root:          map[string]any{"data": map[string]any{"items": []any{1, 2, 3}, "m~n": true}},
+			Path:          "/data/m~0n",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"data": map[string]any{"items": []any{1, 2, 3}}},
+		},
+	) {
+		return
+	}
 }
@@ -0,0 +1,282 @@
+package object
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+// ChangeKind is the kind of difference found by Diff.
+type ChangeKind string
+
+const (
+	// ChangeAdded is a member or element that is only in right.
+	ChangeAdded ChangeKind = "added"
+	// ChangeRemoved is a member or element that is only in left.
+	ChangeRemoved ChangeKind = "removed"
+	// ChangeChanged is a value that differs but is of the same kind in left and right.
+	ChangeChanged ChangeKind = "changed"
+	// ChangeTypeChanged is a value whose kind differs e.g., a string in left and a map in right, or nil in one of them.
+	ChangeTypeChanged ChangeKind = "type-changed"
+)
+
+/*
+Change is a single difference between two values found by Diff.
+
+Path is the concrete path made up of the root, keys, and indexes e.g., `$['users'][0]['name']`.
+Old is the value in left and New is the value in right. Old is nil for ChangeAdded and New is nil for ChangeRemoved.
+*/
+type Change struct {
+	Path path.RecursiveDescentSegment
+	Kind ChangeKind
+	Old  any
+	New  any
+}
+
+// Changes is the list of differences found by Diff.
+type Changes []Change
+
+/*
+Diff returns the differences between left and right.
+
+See AreEqual.Diff.
+*/
+func Diff(left any, right any) Changes {
+	return NewAreEqual().Diff(left, right)
+}
+
+/*
+Diff returns the differences between left and right.
+
+left and right are walked the same way as AreEqualReflect: pointers and interfaces are followed, slices and arrays are compared element by element,
+maps entry by entry, and structs field by field. Values whose type has a custom equality check in `AreEqual.customEquals` are compared with it
+and reported as a single change if they are not equal. Unexported struct fields are not compared.
+
+Changes are ordered as found. Map entries are visited in the order of their keys. Elements removed from the end of a slice are listed from the last one,
+so that the changes can be applied in order with Changes.Patch.
+
+Values that are equal under the options set with AreEqual.WithOptions are not reported e.g., members at ignored paths or floats within the tolerance.
+
+Returns an empty list if left and right are equal.
+*/
+func (n *AreEqual) Diff(left any, right any) Changes {
+	return n.DiffReflect(reflect.ValueOf(left), reflect.ValueOf(right))
+}
+
+// DiffReflect is like Diff but works with reflect.Value.
+func (n *AreEqual) DiffReflect(left reflect.Value, right reflect.Value) Changes {
+	changes := make(Changes, 0)
+	n.diff(left, right, path.RecursiveDescentSegment{{Key: path.JsonpathKeyRoot, IsKeyRoot: true}}, &changes)
+	return changes
+}
+
+// diff appends the differences between left and right at currentPath to changes.
+func (n *AreEqual) diff(left reflect.Value, right reflect.Value, currentPath path.RecursiveDescentSegment, changes *Changes) {
+	if n.hasOptions && n.areEqual(left, right, currentPath) {
+		return
+	}
+
+	for left.IsValid() && left.Kind() == reflect.Interface && !left.IsNil() {
+		left = left.Elem()
+	}
+	for right.IsValid() && right.Kind() == reflect.Interface && !right.IsNil() {
+		right = right.Elem()
+	}
+
+	leftNilOrInvalid := core.IsNilOrInvalid(left)
+	rightNilOrInvalid := core.IsNilOrInvalid(right)
+	if leftNilOrInvalid && rightNilOrInvalid {
+		return
+	}
+
+	addChange := func(kind ChangeKind) {
+		*changes = append(*changes, Change{Path: slices.Clone(currentPath), Kind: kind, Old: diffValue(left), New: diffValue(right)})
+	}
+
+	if n.coerce() && left.IsValid() && right.IsValid() && left.Kind() != right.Kind() {
+		if left.Kind() == reflect.Pointer || right.Kind() == reflect.Pointer {
+			// e.g., a pointer to a struct compared with a map.
+			n.diff(indirectValue(left), indirectValue(right), currentPath, changes)
+			return
+		}
+		if n.options.NumericCoercion && isNumberKind(left.Kind()) && isNumberKind(right.Kind()) {
+			addChange(ChangeChanged)
+			return
+		}
+		if n.options.StructMapCoercion && isStructMapPair(left, right) {
+			n.diffMembers(left, right, currentPath, changes)
+			return
+		}
+	}
+
+	if !left.IsValid() || !right.IsValid() || left.Kind() != right.Kind() {
+		addChange(ChangeTypeChanged)
+		return
+	}
+
+	if customEqualityCheck, ok := n.customEquals[left.Type()]; ok {
+		if !customEqualityCheck.AreEqualReflect(left, right) {
+			addChange(ChangeChanged)
+		}
+		return
+	}
+
+	if leftNilOrInvalid || rightNilOrInvalid {
+		addChange(ChangeChanged)
+		return
+	}
+
+	switch left.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		n.diff(left.Elem(), right.Elem(), currentPath, changes)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < min(left.Len(), right.Len()); i++ {
+			n.diff(left.Index(i), right.Index(i), append(currentPath, &path.CollectionMemberSegment{Index: i, IsIndex: true}), changes)
+		}
+		for i := left.Len() - 1; i >= right.Len(); i-- {
+			*changes = append(*changes, Change{Path: diffChildPath(currentPath, &path.CollectionMemberSegment{Index: i, IsIndex: true}), Kind: ChangeRemoved, Old: diffValue(left.Index(i))})
+		}
+		for i := left.Len(); i < right.Len(); i++ {
+			*changes = append(*changes, Change{Path: diffChildPath(currentPath, &path.CollectionMemberSegment{Index: i, IsIndex: true}), Kind: ChangeAdded, New: diffValue(right.Index(i))})
+		}
+	case reflect.Map:
+		n.diffMembers(left, right, currentPath, changes)
+	case reflect.Struct:
+		if left.Type() != right.Type() {
+			addChange(ChangeTypeChanged)
+			return
+		}
+		for i := 0; i < left.NumField(); i++ {
+			if !core.IsStructFieldExported(left.Type().Field(i)) {
+				continue
+			}
+			n.diff(left.Field(i), right.Field(i), append(currentPath, &path.CollectionMemberSegment{Key: left.Type().Field(i).Name, IsKey: true}), changes)
+		}
+	default:
+		if !reflect.DeepEqual(left.Interface(), right.Interface()) {
+			addChange(ChangeChanged)
+		}
+	}
+}
+
+/*
+diffMembers appends the differences between the members of two maps, or a map and a struct, matched by key like AreEqual.membersEqual.
+
+A member that is only on one side is not reported if it is at an ignored path, or if AreEqualOptions.NilEqualsEmpty is set and it is nil or empty.
+*/
+func (n *AreEqual) diffMembers(left reflect.Value, right reflect.Value, currentPath path.RecursiveDescentSegment, changes *Changes) {
+	rightMembers := getCollectionMembers(right, n.options.FieldNameStrategy)
+	rightMatched := make([]bool, len(rightMembers))
+	for _, leftMember := range getCollectionMembers(left, n.options.FieldNameStrategy) {
+		matched := false
+		for i, rightMember := range rightMembers {
+			if !rightMatched[i] && n.memberKeysEqual(leftMember, rightMember) {
+				rightMatched[i] = true
+				matched = true
+				n.diff(leftMember.value, rightMember.value, append(currentPath, leftMember.segment), changes)
+				break
+			}
+		}
+		if !matched && !n.isMissingMemberEqual(append(currentPath, leftMember.segment), leftMember.value) {
+			*changes = append(*changes, Change{Path: diffChildPath(currentPath, leftMember.segment), Kind: ChangeRemoved, Old: diffValue(leftMember.value)})
+		}
+	}
+	for i, rightMember := range rightMembers {
+		if !rightMatched[i] && !n.isMissingMemberEqual(append(currentPath, rightMember.segment), rightMember.value) {
+			*changes = append(*changes, Change{Path: diffChildPath(currentPath, rightMember.segment), Kind: ChangeAdded, New: diffValue(rightMember.value)})
+		}
+	}
+}
+
+// isMissingMemberEqual returns true if a member at memberPath that is missing on the other side is equal under the options.
+func (n *AreEqual) isMissingMemberEqual(memberPath path.RecursiveDescentSegment, value reflect.Value) bool {
+	return n.isIgnoredPath(memberPath) || (n.options.NilEqualsEmpty && isNilOrEmpty(value))
+}
+
+// diffChildPath returns a copy of currentPath with segment added.
+func diffChildPath(currentPath path.RecursiveDescentSegment, segment *path.CollectionMemberSegment) path.RecursiveDescentSegment {
+	return append(slices.Clone(currentPath), segment)
+}
+
+// diffValue returns value in Go form. Nil pointers, maps, slices, and interfaces become nil.
+func diffValue(value reflect.Value) any {
+	if core.IsNilOrInvalid(value) || !value.CanInterface() {
+		return nil
+	}
+	return value.Interface()
+}
+
+/*
+Patch returns the changes as an RFC 6902 JSON Patch document that turns left into right when applied with ApplyPatch.
+
+ChangeAdded becomes an add operation, ChangeRemoved a remove operation, and ChangeChanged and ChangeTypeChanged a replace operation.
+
+Returns an error if a path cannot be converted into a JSON Pointer.
+*/
+func (n Changes) Patch() (Patch, error) {
+	patch := make(Patch, 0, len(n))
+	for _, change := range n {
+		jsonPointer, err := change.Path.JSONPointer()
+		if err != nil {
+			return nil, err
+		}
+
+		switch change.Kind {
+		case ChangeAdded:
+			patch = append(patch, PatchOperation{Op: PatchOpAdd, Path: jsonPointer, Value: change.New})
+		case ChangeRemoved:
+			patch = append(patch, PatchOperation{Op: PatchOpRemove, Path: jsonPointer})
+		default:
+			patch = append(patch, PatchOperation{Op: PatchOpReplace, Path: jsonPointer, Value: change.New})
+		}
+	}
+	return patch, nil
+}
+
+/*
+UnifiedDiff renders the changes as a unified text diff with a hunk for each change.
+
+Values are rendered as JSON. leftLabel and rightLabel name left and right in the header.
+
+Example:
+
+	--- expected
+	+++ actual
+	@@ $['users'][0]['name'] changed @@
+	-"Alice"
+	+"Bob"
+	@@ $['users'][1] added @@
+	+{"name":"Carol"}
+*/
+func (n Changes) UnifiedDiff(leftLabel string, rightLabel string) string {
+	if len(n) == 0 {
+		return ""
+	}
+
+	builder := new(strings.Builder)
+	builder.WriteString("--- " + leftLabel + "\n")
+	builder.WriteString("+++ " + rightLabel + "\n")
+	for _, change := range n {
+		builder.WriteString(fmt.Sprintf("@@ %s %s @@\n", change.Path.NormalizedString(), change.Kind))
+		if change.Kind != ChangeAdded {
+			builder.WriteString("-" + diffValueString(change.Old) + "\n")
+		}
+		if change.Kind != ChangeRemoved {
+			builder.WriteString("+" + diffValueString(change.New) + "\n")
+		}
+	}
+	return builder.String()
+}
+
+// diffValueString returns value as JSON or in its default format if it cannot be converted to JSON.
+func diffValueString(value any) string {
+	if data, err := json.Marshal(value); err == nil {
+		return string(data)
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,270 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_Diff(t *testing.T) {
+	for testData := range DiffTestData {
+		changes := NewAreEqual().WithCustomEquals(testData.CustomAreEquals).WithOptions(testData.Options).Diff(testData.Left, testData.Right)
+		if unifiedDiff := changes.UnifiedDiff("left", "right"); unifiedDiff != testData.ExpectedUnifiedDiff {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected=\n", testData.ExpectedUnifiedDiff, "\n",
+				"got=\n", unifiedDiff,
+			)
+		}
+
+		if testData.CustomAreEquals != nil || !reflect.ValueOf(testData.Options).IsZero() {
+			continue
+		}
+
+		// applying the changes as a patch to left must produce right.
+		patch, err := changes.Patch()
+		if err != nil {
+			t.Error(testData.TestTitle, "\n", "expected no error, got err=", err)
+			continue
+		}
+		result, err := ApplyPatch(testData.Left, patch)
+		if err != nil || !NewAreEqual().AreEqual(result, testData.Right) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"patch=", core.JsonStringifyMust(patch), "\n",
+				"expected=", core.JsonStringifyMust(testData.Right), "\n",
+				"got=", core.JsonStringifyMust(result), "err=", err,
+			)
+		}
+	}
+}
+
+func TestObject_Diff_AreEqual(t *testing.T) {
+	for testData := range AreEqualTestData {
+		changes := NewAreEqual().WithCustomEquals(testData.CustomAreEquals).WithOptions(testData.Options).Diff(testData.Left, testData.Right)
+		if (len(changes) == 0) != testData.Expected {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected Diff to agree with AreEqual=", testData.Expected, "\n",
+				"got=", changes.UnifiedDiff("left", "right"),
+			)
+		}
+	}
+}
+
+type caseInsensitiveEqual struct{}
+
+func (c caseInsensitiveEqual) AreEqual(left any, right any) bool {
+	return c.AreEqualReflect(reflect.ValueOf(left), reflect.ValueOf(right))
+}
+
+func (c caseInsensitiveEqual) AreEqualReflect(left reflect.Value, right reflect.Value) bool {
+	return strings.EqualFold(left.String(), right.String())
+}
+
+type DiffData struct {
+	internal.TestData
+	Left, Right         any
+	CustomAreEquals     AreEquals
+	Options             AreEqualOptions
+	ExpectedUnifiedDiff string
+}
+
+func DiffTestData(yield func(data *DiffData) bool) {
+	testCaseIndex := 1
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Equal values", testCaseIndex),
+			},
+			Left:                map[string]any{"a": []any{1, "b"}},
+			Right:               map[string]any{"a": []any{1, "b"}},
+			ExpectedUnifiedDiff: "",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Map members added, removed, changed and type-changed", testCaseIndex),
+			},
+			Left:  map[string]any{"name": "Alice", "age": 30, "tags": []any{"a"}, "address": map[string]any{"city": "Anytown"}},
+			Right: map[string]any{"name": "Bob", "email": "bob@example.com", "tags": "a", "address": map[string]any{"city": "Anytown", "zip": nil}},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['address']['zip'] added @@
++null
+@@ $['age'] removed @@
+-30
+@@ $['name'] changed @@
+-"Alice"
++"Bob"
+@@ $['tags'] type-changed @@
+-["a"]
++"a"
+@@ $['email'] added @@
++"bob@example.com"
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Slice elements", testCaseIndex),
+			},
+			Left:  map[string]any{"shorter": []any{1, 2, 3, 4}, "longer": []any{1}},
+			Right: map[string]any{"shorter": []any{1, 5}, "longer": []any{1, 2, 3}},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['longer'][1] added @@
++2
+@@ $['longer'][2] added @@
++3
+@@ $['shorter'][1] changed @@
+-2
++5
+@@ $['shorter'][3] removed @@
+-4
+@@ $['shorter'][2] removed @@
+-3
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Structs and pointers", testCaseIndex),
+			},
+			Left:  &UserProfile{Name: "Alice", Age: 30, Address: Address{City: "Anytown", ZipCode: core.Ptr("1234")}},
+			Right: &UserProfile{Name: "Alice", Age: 31, Address: Address{City: "Othertown"}},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['Age'] changed @@
+-30
++31
+@@ $['Address']['City'] changed @@
+-"Anytown"
++"Othertown"
+@@ $['Address']['ZipCode'] changed @@
+-"1234"
++null
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Root type changed", testCaseIndex),
+			},
+			Left:  []any{1},
+			Right: map[string]any{"a": 1},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $ type-changed @@
+-[1]
++{"a":1}
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Custom equality", testCaseIndex),
+			},
+			Left:            map[string]any{"a": "Hello", "b": "x"},
+			Right:           map[string]any{"a": "HELLO", "b": "y"},
+			CustomAreEquals: AreEquals{reflect.TypeOf(""): caseInsensitiveEqual{}},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['b'] changed @@
+-"x"
++"y"
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Members only on one side at ignored paths", testCaseIndex),
+			},
+			Left:    map[string]any{"id": 1, "ts": 100},
+			Right:   map[string]any{"id": 2},
+			Options: AreEqualOptions{IgnorePaths: []path.JSONPath{"$.ts"}},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['id'] changed @@
+-1
++2
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Missing members equal to nil or empty members", testCaseIndex),
+			},
+			Left:    map[string]any{"tags": []any{}, "name": "a"},
+			Right:   map[string]any{"name": "a", "meta": nil, "extra": 1},
+			Options: AreEqualOptions{NilEqualsEmpty: true},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['extra'] added @@
++1
+`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&DiffData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Struct compared with map member by member", testCaseIndex),
+			},
+			Left:    &User{ID: 1, Name: "Alice"},
+			Right:   map[string]any{"ID": 2.0, "Name": "Alice", "Email": ""},
+			Options: AreEqualOptions{StructMapCoercion: true, NumericCoercion: true},
+			ExpectedUnifiedDiff: `--- left
++++ right
+@@ $['ID'] changed @@
+-1
++2
+`,
+		},
+	) {
+		return
+	}
+}
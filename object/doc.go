@@ -5,10 +5,25 @@ It is designed to work with data structures where the type might not be known at
 
 Key features:
   - **Get**: Retrieve values from an object using a JSONPath query.
+  - **GetAs, GetAllAs, GetAsOrDefault, MustGetAs**: Retrieve values as a type parameter. Values are converted using the Object's default converter.
   - **Set**: Create or update values at a specific JSONPath. Supports auto-creation of nested structures if a Schema is provided.
+  - **Insert, Append**: Insert a value into a slice at an index shifting the elements after it, or append values to a slice. Use `-` as the index to append e.g., `$.items[-]`.
   - **Delete**: Remove values at a specific JSONPath.
   - **ForEach**: Iterate over all values matching a JSONPath query.
-  - **AreEqual**: Deep equality check with support for custom equality handlers.
+  - **GetAll**: Retrieve all values matching a JSONPath query along with the normalized path to each value.
+  - **All, Values**: Range over the values matching a JSONPath query with a for loop. Values converts each value to a type parameter. Use Iterate or IterateValues to check for errors after the loop.
+  - **Update**: Replace each value matching a JSONPath query with the value returned by a callback, or delete it. Returns the number of values found and modified.
+  - **DeleteWhere, RetainWhere**: Delete the values matching a JSONPath query for which a predicate returns true, or false, respectively.
+  - **GetCompiled, SetCompiled, DeleteCompiled, ForEachCompiled, GetAllCompiled, InsertCompiled, AppendCompiled, UpdateCompiled, DeleteWhereCompiled, RetainWhereCompiled**: Same as the methods above but use a path.CompiledPath to avoid parsing the same path on every call.
+  - **AreEqual**: Deep equality check with support for custom equality handlers and options (ignored paths, float tolerance, unordered arrays, nil vs empty, numeric and struct vs map coercion).
+  - **Diff**: List the differences between two values with the concrete path to each. Render them as a JSON Patch or a unified text diff.
+  - **ApplyPatch**: Apply an RFC 6902 JSON Patch document (add, remove, replace, move, copy, test) all-or-nothing.
+  - **MergePatch, CreateMergePatch**: Apply or generate an RFC 7386 JSON Merge Patch. Works on structs and typed maps using the schema and converter.
+  - **Clone, CloneReflect**: Deep copy structs, maps, slices, arrays, pointers, and interfaces keeping their types. Shared pointers stay shared and cycles are copied. Use NewDeepClone for unexported fields and custom cloners.
+  - **Flatten, Unflatten**: Convert to and from a map of leaves keyed by normalized JSONPath, JSON Pointer, or dotted path. Unflatten recreates typed structs and slices using a schema. Empty collections are kept so a round trip returns the same document.
+  - **Pick, Omit, PickProjection**: Copy the source keeping only, or leaving out, the values at a set of JSONPaths. PickProjection returns a map[string]any along with a schema describing it derived from the Object's schema.
+  - **Batch**: Run several modifications on a working copy of the source. The source is only replaced if all of them succeed.
+  - **Snapshot, Restore, Undo**: Save and restore deep copies of the source, or undo the last Batch, Restore, ApplyPatch, or MergePatch.
 
 # Core Concepts
 
@@ -22,11 +37,17 @@ To begin:
 
 The following parameters can be set using the builder method (prefixed `With`) or Set (prefixed `Set) before calling the manipulation methods:
   - Object.source - Mandatory. This is the root object to work with.
-  - Object.defaultConverter - Mandatory for Object.Set. Module to use when converting `Object.valueToSet` to the destination type at path.JSONPath. When Object is instantiated using NewObject, it will be set with schema.NewConversion.
+  - Object.defaultConverter - Mandatory for Object.Set. Module to use when converting the value to set to the destination type at path.JSONPath. When Object is instantiated using NewObject, it will be set with schema.NewConversion.
   - Object.schema - Optional but useful for Object.Set. Used to determine the new collections (especially structs) to create in the nested object. Defaults to json collections (core.JsonObject and core.JsonArray).
+  - Object.fieldNameStrategy - Optional. How struct fields are resolved in a path.JSONPath e.g., core.FieldNameJSONTag to use the names in `json` tags. Defaults to the Go name of the field. Fields of embedded structs are promoted like in Go.
+  - Object.copyOnWrite - Optional. If true, Set and Delete replace `Object.source` with a new root that shares every unmodified collection with the previous one instead of modifying it.
+  - Object.undoDepth - Optional. Number of source replacements that can be undone with Object.Undo. Defaults to 0 which disables Object.Undo.
 
 2. Once the Object has been successfully initialized, you can begin calling the manipulation methods: Object.Get, Object.Set, Object.Delete, and `Object.ForEach, which will work on the same `Object.source`.
 
+Each call keeps its own state and returns its results hence Object.Get, Object.GetAll, and Object.ForEach can be called from several goroutines at the same time.
+Wrap the Object with NewSyncObject if goroutines also call Object.Set or Object.Delete.
+
 3. Once you are satisfied, you can call the `Object.GetSourceInterface` method to retrieve the modified source especially if changed using `Object.Set` or `Object.Delete`.
 
 Example:
@@ -54,15 +75,24 @@ Example:
 
 	objManip := NewObject().WithSourceInterface(source)
 
-	noOfResults, err := objManip.Get("$.data.metadata.Address.City")
-	var valueFound any
-	if noOfResults > 0 {
-		// retrieve value found if no of results is greater than 0
-		valueFound = objManip.GetValueFoundInterface()
-	}
+	// valueFound is nil if noOfResults is 0
+	valueFound, noOfResults, err := objManip.Get("$.data.metadata.Address.City")
 
 	noOfModifications, err := objManip.Set("$.data.metadata.Status", "inactive")
 
+	// JSON Pointers can be used in place of a JSONPath
+	valueFound, noOfResults, err = objManip.Get("/data/metadata/Address/City")
+
+	// filter selectors select the members of a collection for which the expression is true
+	valueFound, noOfResults, err = objManip.Get("$.data[?@.Status == 'inactive'].Address.City")
+
+	// each result holds the concrete path e.g., `$['data']['metadata']['Status']`
+	results, err := objManip.GetAll("$..Status")
+
+	// paths used repeatedly can be compiled once
+	compiledPath := path.MustCompile("$.data.metadata.Address.City")
+	valueFound, noOfResults, err = objManip.GetCompiled(compiledPath)
+
 	noOfModifications, err = objManip.Delete("$.data.metadata.Status")
 
 	// retrieve modified source after Set/Delete
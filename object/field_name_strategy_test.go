@@ -0,0 +1,268 @@
+package object
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/schema"
+)
+
+type taggedAddress struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+}
+
+type taggedUser struct {
+	FirstName string         `json:"first_name"`
+	Age       int            `json:"age,omitempty"`
+	Password  string         `json:"-"`
+	Address   *taggedAddress `json:"address"`
+}
+
+func taggedUserSchema() *schema.DynamicSchemaNode {
+	return &schema.DynamicSchemaNode{
+		Kind: reflect.Struct,
+		Type: reflect.TypeOf(taggedUser{}),
+		ChildNodes: schema.ChildNodes{
+			"FirstName": &schema.DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+			"Age":       &schema.DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)},
+			"Password":  &schema.DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+			"Address": &schema.DynamicSchemaNode{
+				Kind: reflect.Pointer,
+				Type: reflect.TypeOf(&taggedAddress{}),
+				ChildNodesPointerSchema: &schema.DynamicSchemaNode{
+					Kind: reflect.Struct,
+					Type: reflect.TypeOf(taggedAddress{}),
+					ChildNodes: schema.ChildNodes{
+						"Street": &schema.DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+						"City":   &schema.DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTaggedUser() *taggedUser {
+	return &taggedUser{
+		FirstName: "Alice",
+		Age:       30,
+		Password:  "secret",
+		Address:   &taggedAddress{Street: "1 Main St", City: "Anytown"},
+	}
+}
+
+func TestObject_FieldNameStrategy_Get(t *testing.T) {
+	obj := NewObject().WithSourceInterface(newTaggedUser()).WithFieldNameStrategy(core.FieldNameJSONTag)
+
+	if value, ok, err := obj.Get("$.first_name"); ok != 1 || value != "Alice" {
+		t.Error("expected=Alice got=", value, "err=", err)
+	}
+	if value, ok, err := obj.Get("$.address.city"); ok != 1 || value != "Anytown" {
+		t.Error("expected=Anytown got=", value, "err=", err)
+	}
+	if value, ok, err := obj.Get("$..city"); ok != 1 || !reflect.DeepEqual(value, []any{"Anytown"}) {
+		t.Error("expected=[Anytown] got=", value, "err=", err)
+	}
+	if value, ok, err := obj.Get("$['first_name','age']"); ok != 2 || !reflect.DeepEqual(value, []any{"Alice", 30}) {
+		t.Error("expected=[Alice 30] got=", value, "err=", err)
+	}
+	if value, ok, err := obj.Get("$.address.*"); ok != 2 || !reflect.DeepEqual(value, []any{"1 Main St", "Anytown"}) {
+		t.Error("expected=[1 Main St Anytown] got=", value, "err=", err)
+	}
+
+	if _, ok, err := obj.Get("$.FirstName"); ok != 0 || !errors.Is(err, ErrPathSegmentInvalidError) {
+		t.Error("expected Go name to not be resolved, got ok=", ok, "err=", err)
+	}
+	if _, ok, _ := obj.Get("$.Password"); ok != 0 {
+		t.Error("expected field tagged `json:\"-\"` to not be resolved")
+	}
+	if value, ok, err := NewObject().WithSourceInterface([]any{newTaggedUser()}).WithFieldNameStrategy(core.FieldNameJSONTag).Get("$[?length(@) == 3].first_name"); ok != 1 || !reflect.DeepEqual(value, []any{"Alice"}) {
+		t.Error("expected length() to count the fields resolved by the strategy, got=", value, "err=", err)
+	}
+
+	obj.SetFieldNameStrategy(core.FieldNameCaseInsensitive)
+	if value, ok, err := obj.Get("$.ADDRESS.city"); ok != 1 || value != "Anytown" {
+		t.Error("expected=Anytown got=", value, "err=", err)
+	}
+}
+
+func TestObject_FieldNameStrategy_SetDelete(t *testing.T) {
+	user := newTaggedUser()
+	obj := NewObject().WithSourceInterface(user).WithSchema(taggedUserSchema()).WithFieldNameStrategy(core.FieldNameJSONTag)
+
+	if ok, err := obj.Set("$.address.city", "Othertown"); ok != 1 || user.Address.City != "Othertown" {
+		t.Error("expected=Othertown got=", user.Address.City, "err=", err)
+	}
+	if ok, err := obj.Set("$.age", "42"); ok != 1 || user.Age != 42 {
+		t.Error("expected=42 got=", user.Age, "err=", err)
+	}
+	if ok, err := obj.Set("$..street", "2 Side St"); ok != 1 || user.Address.Street != "2 Side St" {
+		t.Error("expected=2 Side St got=", user.Address.Street, "err=", err)
+	}
+
+	obj.SetDefaultConverter(schema.NewConversion().WithFieldNameStrategy(core.FieldNameJSONTag))
+	if ok, err := obj.Set("$.address", map[string]any{"street": "3 High St", "city": "Newtown"}); ok != 1 || !reflect.DeepEqual(user.Address, &taggedAddress{Street: "3 High St", City: "Newtown"}) {
+		t.Error("expected address to be converted from map, got=", user.Address, "err=", err)
+	}
+
+	if ok, err := obj.Delete("$.first_name"); ok != 1 || user.FirstName != "" {
+		t.Error("expected first_name to be deleted, got=", user.FirstName, "err=", err)
+	}
+	if ok, err := obj.Delete("$.address['street','city']"); ok != 2 || *user.Address != (taggedAddress{}) {
+		t.Error("expected address to be cleared, got=", user.Address, "err=", err)
+	}
+	if _, err := obj.Delete("$.Password"); !errors.Is(err, ErrPathSegmentInvalidError) || user.Password != "secret" {
+		t.Error("expected field tagged `json:\"-\"` to not be deleted, got err=", err)
+	}
+}
+
+func TestObject_FieldNameStrategy_ForEach(t *testing.T) {
+	obj := NewObject().WithSourceInterface(newTaggedUser()).WithFieldNameStrategy(core.FieldNameJSONTag.WithCaseInsensitive(true))
+
+	paths := make([]string, 0)
+	for resultPath := range obj.All("$['FIRST_NAME', 'address']..*") {
+		paths = append(paths, resultPath.String())
+	}
+	if expected := []string{"$.address.street", "$.address.city"}; !reflect.DeepEqual(paths, expected) {
+		t.Error("expected=", expected, "got=", paths)
+	}
+
+	results, err := obj.GetAll("$.First_Name")
+	if err != nil || len(results) != 1 || results[0].Path.String() != "$.first_name" {
+		t.Error("expected the path to have the resolved name, got=", results, "err=", err)
+	}
+}
+
+func TestObject_FieldNameStrategy_AreEqual(t *testing.T) {
+	decoded := map[string]any{"first_name": "Alice", "age": float64(30), "address": map[string]any{"street": "1 Main St", "city": "Anytown"}}
+	areEqual := NewAreEqual().WithOptions(AreEqualOptions{NumericCoercion: true, StructMapCoercion: true, FieldNameStrategy: core.FieldNameJSONTag})
+
+	if !areEqual.AreEqual(decoded, newTaggedUser()) {
+		t.Error("expected map with json tag keys to equal the tagged struct")
+	}
+
+	decoded["address"].(map[string]any)["city"] = "Othertown"
+	changes := areEqual.Diff(decoded, newTaggedUser())
+	if len(changes) != 1 || changes[0].Path.String() != "$.address.city" || changes[0].Kind != ChangeChanged {
+		t.Error("expected city to be changed, got=", changes)
+	}
+}
+
+type BaseEntity struct {
+	ID      int
+	Version int
+}
+
+type Audit struct {
+	CreatedBy string
+	Version   int
+}
+
+type Order struct {
+	BaseEntity
+	*Audit
+	Items []string
+}
+
+func TestObject_FieldNameStrategy_Embedded(t *testing.T) {
+	order := &Order{BaseEntity: BaseEntity{ID: 1}, Items: []string{"a"}}
+	obj := NewObject().WithSourceInterface(order)
+
+	if value, ok, err := obj.Get("$.ID"); ok != 1 || value != 1 {
+		t.Error("expected=1 got=", value, "err=", err)
+	}
+	if value, ok, err := obj.Get("$.BaseEntity.ID"); ok != 1 || value != 1 {
+		t.Error("expected=1 got=", value, "err=", err)
+	}
+	if _, ok, _ := obj.Get("$.CreatedBy"); ok != 0 {
+		t.Error("expected no value for field promoted through nil pointer")
+	}
+	if _, ok, err := obj.Get("$.Version"); ok != 0 || err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Error("expected ambiguity error, got err=", err)
+	}
+	if value, ok, err := obj.Get("$.*"); ok != 2 || !reflect.DeepEqual(value, []any{1, []string{"a"}}) {
+		t.Error("expected=[1 [a]] got=", value, "err=", err)
+	}
+
+	if ok, err := obj.Set("$.CreatedBy", "admin"); ok != 1 || order.Audit == nil || order.CreatedBy != "admin" {
+		t.Error("expected embedded pointer to be allocated, got=", order.Audit, "err=", err)
+	}
+	if ok, err := obj.Set("$.ID", 2); ok != 1 || order.ID != 2 {
+		t.Error("expected=2 got=", order.ID, "err=", err)
+	}
+	if _, err := obj.Set("$.Version", 3); err == nil || !errors.Is(err, ErrPathSegmentInvalidError) {
+		t.Error("expected ambiguity error, got err=", err)
+	}
+	if ok, err := obj.Set("$.BaseEntity.Version", 3); ok != 1 || order.BaseEntity.Version != 3 {
+		t.Error("expected=3 got=", order.BaseEntity.Version, "err=", err)
+	}
+
+	paths := make([]string, 0)
+	for resultPath := range obj.All("$..*") {
+		paths = append(paths, resultPath.String())
+	}
+	if expected := []string{"$.ID", "$.CreatedBy", "$.Items", "$.Items[0]"}; !reflect.DeepEqual(paths, expected) {
+		t.Error("expected=", expected, "got=", paths)
+	}
+
+	if ok, err := obj.Delete("$.CreatedBy"); ok != 1 || order.CreatedBy != "" {
+		t.Error("expected CreatedBy to be deleted, got=", order.CreatedBy, "err=", err)
+	}
+	if ok, err := obj.Delete("$['ID','Items']"); ok != 2 || order.ID != 0 || order.Items != nil {
+		t.Error("expected ID and Items to be deleted, got=", order, "err=", err)
+	}
+
+	emptyOrder := &Order{}
+	if ok, _ := NewObject().WithSourceInterface(emptyOrder).Delete("$.CreatedBy"); ok != 0 || emptyOrder.Audit != nil {
+		t.Error("expected Delete to not allocate embedded pointer")
+	}
+}
+
+func TestObject_FieldNameStrategy_EmbeddedSchema(t *testing.T) {
+	baseEntitySchema := &schema.DynamicSchemaNode{
+		Kind: reflect.Struct,
+		Type: reflect.TypeOf(BaseEntity{}),
+		ChildNodes: schema.ChildNodes{
+			"ID":      &schema.DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)},
+			"Version": &schema.DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)},
+		},
+	}
+	orderSchema := &schema.DynamicSchemaNode{
+		Kind: reflect.Struct,
+		Type: reflect.TypeOf(Order{}),
+		ChildNodes: schema.ChildNodes{
+			"BaseEntity": baseEntitySchema,
+			// schema of promoted fields without a schema for the embedded struct.
+			"CreatedBy": &schema.DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+			"Items": &schema.DynamicSchemaNode{
+				Kind:                                     reflect.Slice,
+				Type:                                     reflect.TypeOf([]string{}),
+				Nilable:                                  true,
+				ChildNodesLinearCollectionElementsSchema: &schema.DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+			},
+		},
+	}
+
+	order := &Order{}
+	obj := NewObject().WithSourceInterface(order).WithSchema(orderSchema)
+	if ok, err := obj.Set("$.ID", "7"); ok != 1 || order.ID != 7 {
+		t.Error("expected=7 got=", order.ID, "err=", err)
+	}
+	if ok, err := obj.Set("$.CreatedBy", 42); ok != 1 || order.CreatedBy != "42" {
+		t.Error("expected=42 got=", order.Audit, "err=", err)
+	}
+
+	var converted Order
+	if err := schema.NewConversion().Convert(map[string]any{"ID": 1, "CreatedBy": "admin", "Items": []any{"a"}}, orderSchema, &converted); err != nil ||
+		!reflect.DeepEqual(converted, Order{BaseEntity: BaseEntity{ID: 1}, Audit: &Audit{CreatedBy: "admin"}, Items: []string{"a"}}) {
+		t.Error("expected map with promoted keys to be converted, got=", converted, "err=", err)
+	}
+
+	if ok, err := schema.NewValidation().ValidateData(*order, orderSchema); !ok {
+		t.Error("expected order to be valid, err=", err)
+	}
+}
@@ -0,0 +1,442 @@
+package object
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+// hasFilterSelector returns true if segment is a filter selector or a union selector containing one.
+func hasFilterSelector(segment *path.CollectionMemberSegment) bool {
+	if segment == nil {
+		return false
+	}
+	if segment.FilterSelector != nil {
+		return true
+	}
+	for _, unionKey := range segment.UnionSelector {
+		if unionKey.FilterSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+resolveFilterSelector evaluates the filter selectors in segment against the members of currentValue.
+
+It returns a union selector made up of the keys (maps and structs) or indexes (arrays and slices) of the members that satisfied the filters, along with any non-filter members of the original union.
+This allows the filter to be processed by the same logic that handles union selectors.
+
+Returns false and sets traversal.lastError if no member was selected.
+*/
+func (n *traversal) resolveFilterSelector(currentValue reflect.Value, segment *path.CollectionMemberSegment, currentPath path.RecursiveDescentSegment) (*path.CollectionMemberSegment, bool) {
+	const FunctionName = "resolveFilterSelector"
+
+	filters := path.RecursiveDescentSegment{segment}
+	if segment.FilterSelector == nil {
+		filters = segment.UnionSelector
+	}
+
+	resolvedSegment := &path.CollectionMemberSegment{
+		UnionSelector:     make(path.RecursiveDescentSegment, 0),
+		ExpectLinear:      true,
+		ExpectAssociative: true,
+	}
+
+	members := getCollectionMembers(currentValue, n.fieldNameStrategy)
+	for _, filter := range filters {
+		if filter.FilterSelector == nil {
+			resolvedSegment.UnionSelector = append(resolvedSegment.UnionSelector, filter)
+			continue
+		}
+
+		for _, member := range members {
+			if n.evaluateFilterExpression(filter.FilterSelector, member.value) {
+				resolvedSegment.UnionSelector = append(resolvedSegment.UnionSelector, member.segment)
+			}
+		}
+	}
+
+	if len(resolvedSegment.UnionSelector) == 0 {
+		var val any
+		if currentValue.IsValid() && currentValue.CanInterface() {
+			val = currentValue.Interface()
+		}
+		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("filter selector %s yielded no results", segment)).
+			WithNestedError(ErrValueAtPathSegmentInvalidError).
+			WithData(core.JsonObject{"CurrentValue": val, "CurrentPathSegment": currentPath})
+		return nil, false
+	}
+
+	return resolvedSegment, true
+}
+
+// evaluateFilterExpression returns true if currentValue satisfies the logical expression.
+func (n *traversal) evaluateFilterExpression(expression *path.FilterExpression, currentValue reflect.Value) bool {
+	if expression == nil {
+		return false
+	}
+
+	switch {
+	case expression.Operator == path.FilterOperatorOr:
+		for _, operand := range expression.Operands {
+			if n.evaluateFilterExpression(operand, currentValue) {
+				return true
+			}
+		}
+		return false
+	case expression.Operator == path.FilterOperatorAnd:
+		for _, operand := range expression.Operands {
+			if !n.evaluateFilterExpression(operand, currentValue) {
+				return false
+			}
+		}
+		return len(expression.Operands) > 0
+	case expression.Operator == path.FilterOperatorNot:
+		return len(expression.Operands) == 1 && !n.evaluateFilterExpression(expression.Operands[0], currentValue)
+	case expression.Operator == path.FilterOperatorExists:
+		return len(expression.Operands) == 1 && len(n.evaluateFilterQuery(expression.Operands[0].Query, currentValue)) > 0
+	case expression.Operator.IsComparison():
+		if len(expression.Operands) != 2 {
+			return false
+		}
+		return compareFilterOperands(expression.Operator, n.evaluateFilterComparable(expression.Operands[0], currentValue), n.evaluateFilterComparable(expression.Operands[1], currentValue))
+	case expression.Operator == path.FilterOperatorFunction:
+		result, ok := n.evaluateFilterFunction(expression, currentValue)
+		if !ok {
+			return false
+		}
+		if result.Type == path.FilterFunctionTypeNodes {
+			return len(result.Nodes) > 0
+		}
+		return result.Type == path.FilterFunctionTypeLogical && result.Logical
+	default:
+		return false
+	}
+}
+
+/*
+filterOperand is the value of one side of a comparison.
+
+isNothing is true when a query selected no value, which is distinct from selecting a null value.
+*/
+type filterOperand struct {
+	value     reflect.Value
+	isNothing bool
+}
+
+// evaluateFilterComparable returns the value of a literal or a singular query.
+func (n *traversal) evaluateFilterComparable(expression *path.FilterExpression, currentValue reflect.Value) filterOperand {
+	switch expression.Operator {
+	case path.FilterOperatorLiteral:
+		return filterOperand{value: reflect.ValueOf(expression.Literal)}
+	case path.FilterOperatorQuery:
+		values := n.evaluateFilterQuery(expression.Query, currentValue)
+		if len(values) != 1 {
+			return filterOperand{isNothing: true}
+		}
+		return filterOperand{value: values[0]}
+	case path.FilterOperatorFunction:
+		result, ok := n.evaluateFilterFunction(expression, currentValue)
+		if !ok || result.Type != path.FilterFunctionTypeValue || result.IsNothing {
+			return filterOperand{isNothing: true}
+		}
+		return filterOperand{value: result.Value}
+	default:
+		return filterOperand{isNothing: true}
+	}
+}
+
+/*
+evaluateFilterFunction calls the registered path.FilterFunction in expression with arguments converted to the types of its parameters.
+
+Returns false if the function is not registered or the number of arguments does not match.
+*/
+func (n *traversal) evaluateFilterFunction(expression *path.FilterExpression, currentValue reflect.Value) (path.FilterFunctionValue, bool) {
+	function, ok := path.GetFilterFunction(expression.Function)
+	if !ok || len(function.Parameters) != len(expression.Operands) {
+		return path.FilterFunctionValue{}, false
+	}
+
+	arguments := make([]path.FilterFunctionValue, len(function.Parameters))
+	for i, parameter := range function.Parameters {
+		operand := expression.Operands[i]
+		argument := path.FilterFunctionValue{Type: parameter, FieldNameStrategy: n.fieldNameStrategy}
+
+		switch parameter {
+		case path.FilterFunctionTypeValue:
+			comparable := n.evaluateFilterComparable(operand, currentValue)
+			argument.Value = comparable.value
+			argument.IsNothing = comparable.isNothing
+		case path.FilterFunctionTypeNodes:
+			if operand.Operator == path.FilterOperatorFunction {
+				if result, ok := n.evaluateFilterFunction(operand, currentValue); ok {
+					argument.Nodes = result.Nodes
+				}
+			} else {
+				argument.Nodes = n.evaluateFilterQuery(operand.Query, currentValue)
+			}
+		case path.FilterFunctionTypeLogical:
+			argument.Logical = n.evaluateFilterExpression(operand, currentValue)
+		}
+
+		arguments[i] = argument
+	}
+
+	return function.Evaluate(arguments), true
+}
+
+// evaluateFilterQuery returns the values selected by query starting from currentValue (`@`) or traversal.root (`$`).
+func (n *traversal) evaluateFilterQuery(query *path.FilterQuery, currentValue reflect.Value) []reflect.Value {
+	if query == nil {
+		return nil
+	}
+
+	source := n.root
+	if query.IsRelative {
+		source = currentValue
+	}
+
+	if len(query.Segments) == 1 && len(query.Segments[0]) == 1 {
+		return []reflect.Value{source}
+	}
+
+	values := make([]reflect.Value, 0)
+	queryTraversal := &traversal{
+		source:                   source,
+		root:                     n.root,
+		schema:                   n.schema,
+		defaultConverter:         n.defaultConverter,
+		fieldNameStrategy:        n.fieldNameStrategy,
+		recursiveDescentSegments: query.Segments,
+		ifValueFoundInObject: func(_ path.RecursiveDescentSegment, value reflect.Value) bool {
+			values = append(values, value)
+			return false
+		},
+	}
+	queryTraversal.forEach()
+	return values
+}
+
+// compareFilterOperands applies a comparison operator following the semantics of RFC 9535.
+func compareFilterOperands(operator path.FilterOperator, left filterOperand, right filterOperand) bool {
+	switch operator {
+	case path.FilterOperatorEqual:
+		return filterOperandsEqual(left, right)
+	case path.FilterOperatorNotEqual:
+		return !filterOperandsEqual(left, right)
+	case path.FilterOperatorLess:
+		return filterOperandsLess(left, right)
+	case path.FilterOperatorLessOrEqual:
+		return filterOperandsLess(left, right) || filterOperandsEqual(left, right)
+	case path.FilterOperatorGreater:
+		return filterOperandsLess(right, left)
+	case path.FilterOperatorGreaterOrEqual:
+		return filterOperandsLess(right, left) || filterOperandsEqual(left, right)
+	default:
+		return false
+	}
+}
+
+// filterOperandsEqual compares numbers by value, and arrays, maps and structs by deep equality.
+func filterOperandsEqual(left filterOperand, right filterOperand) bool {
+	if left.isNothing || right.isNothing {
+		return left.isNothing && right.isNothing
+	}
+
+	leftValue := indirectValue(left.value)
+	rightValue := indirectValue(right.value)
+
+	leftNil := core.IsNilOrInvalid(leftValue)
+	rightNil := core.IsNilOrInvalid(rightValue)
+	if leftNil || rightNil {
+		return leftNil && rightNil
+	}
+
+	if isNumberKind(leftValue.Kind()) && isNumberKind(rightValue.Kind()) {
+		return compareNumbers(leftValue, rightValue) == 0
+	}
+
+	switch {
+	case leftValue.Kind() == reflect.String && rightValue.Kind() == reflect.String:
+		return leftValue.String() == rightValue.String()
+	case leftValue.Kind() == reflect.Bool && rightValue.Kind() == reflect.Bool:
+		return leftValue.Bool() == rightValue.Bool()
+	case leftValue.Kind() != rightValue.Kind():
+		return false
+	}
+
+	return NewAreEqual().AreEqualReflect(leftValue, rightValue)
+}
+
+// filterOperandsLess returns true if left < right. Only numbers and strings can be ordered.
+func filterOperandsLess(left filterOperand, right filterOperand) bool {
+	if left.isNothing || right.isNothing {
+		return false
+	}
+
+	leftValue := indirectValue(left.value)
+	rightValue := indirectValue(right.value)
+	if core.IsNilOrInvalid(leftValue) || core.IsNilOrInvalid(rightValue) {
+		return false
+	}
+
+	if isNumberKind(leftValue.Kind()) && isNumberKind(rightValue.Kind()) {
+		return compareNumbers(leftValue, rightValue) < 0
+	}
+	if leftValue.Kind() == reflect.String && rightValue.Kind() == reflect.String {
+		return leftValue.String() < rightValue.String()
+	}
+	return false
+}
+
+// indirectValue unwraps pointers and interfaces. Returns an invalid value if a nil pointer or interface is encountered.
+func indirectValue(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareNumbers compares two numeric values of any kind, exactly for integers and as float64 otherwise.
+func compareNumbers(left reflect.Value, right reflect.Value) int {
+	leftIsInt := left.CanInt()
+	rightIsInt := right.CanInt()
+	leftIsUint := left.CanUint()
+	rightIsUint := right.CanUint()
+
+	switch {
+	case leftIsInt && rightIsInt:
+		return cmp.Compare(left.Int(), right.Int())
+	case leftIsUint && rightIsUint:
+		return cmp.Compare(left.Uint(), right.Uint())
+	case leftIsInt && rightIsUint:
+		if left.Int() < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(left.Int()), right.Uint())
+	case leftIsUint && rightIsInt:
+		if right.Int() < 0 {
+			return 1
+		}
+		return cmp.Compare(left.Uint(), uint64(right.Int()))
+	}
+
+	return cmp.Compare(numberAsFloat(left), numberAsFloat(right))
+}
+
+func numberAsFloat(value reflect.Value) float64 {
+	switch {
+	case value.CanInt():
+		return float64(value.Int())
+	case value.CanUint():
+		return float64(value.Uint())
+	default:
+		return value.Float()
+	}
+}
+
+// collectionMember is a direct child of a map, array/slice, or struct.
+type collectionMember struct {
+	// segment is the key (maps and structs) or index (arrays and slices) of the member.
+	segment *path.CollectionMemberSegment
+	value   reflect.Value
+	// mapKey is set for map entries.
+	mapKey reflect.Value
+}
+
+/*
+getCollectionMembers returns the direct children of value after unwrapping pointers and interfaces.
+
+Map entries are sorted by key so that the order is stable, array/slice elements are ordered by index, and exported struct fields follow their declaration order.
+Struct fields are keyed by their name resolved with fieldNameStrategy including fields promoted from embedded structs.
+*/
+func getCollectionMembers(value reflect.Value, fieldNameStrategy *core.FieldNameStrategy) []collectionMember {
+	value = indirectValue(value)
+	if !value.IsValid() {
+		return nil
+	}
+
+	if _, _, ok := core.GetMapKeyValueType(value); ok {
+		members := make([]collectionMember, 0, value.Len())
+		for _, mapKey := range value.MapKeys() {
+			mapValue := value.MapIndex(mapKey)
+			if !mapValue.IsValid() {
+				continue
+			}
+			members = append(members, collectionMember{
+				segment: &path.CollectionMemberSegment{Key: mapKeyString(mapKey), IsKey: true},
+				value:   mapValue,
+				mapKey:  mapKey,
+			})
+		}
+		slices.SortFunc(members, func(a, b collectionMember) int {
+			return cmp.Compare(a.segment.Key, b.segment.Key)
+		})
+		return members
+	}
+
+	if _, ok := core.GetArraySliceValueType(value); ok {
+		members := make([]collectionMember, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			members = append(members, collectionMember{
+				segment: &path.CollectionMemberSegment{Index: i, IsIndex: true},
+				value:   value.Index(i),
+			})
+		}
+		return members
+	}
+
+	if value.Kind() == reflect.Struct {
+		structFields := fieldNameStrategy.Fields(value.Type())
+		members := make([]collectionMember, 0, len(structFields))
+		for _, structField := range structFields {
+			structFieldValue := structField.Value(value)
+			if !structFieldValue.IsValid() {
+				// promoted through a nil pointer to an embedded struct.
+				continue
+			}
+			members = append(members, collectionMember{
+				segment: &path.CollectionMemberSegment{Key: structField.Name, IsKey: true},
+				value:   structFieldValue,
+			})
+		}
+		return members
+	}
+
+	return nil
+}
+
+// isCollection returns true if value, after unwrapping pointers and interfaces, is a map, array/slice, or struct.
+func isCollection(value reflect.Value) bool {
+	value = indirectValue(value)
+	if !value.IsValid() {
+		return false
+	}
+	if _, _, ok := core.GetMapKeyValueType(value); ok {
+		return true
+	}
+	if _, ok := core.GetArraySliceValueType(value); ok {
+		return true
+	}
+	return value.Kind() == reflect.Struct
+}
@@ -0,0 +1,159 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_FilterFunctions(t *testing.T) {
+	if _, ok := path.GetFilterFunction("has_prefix"); !ok {
+		if err := path.RegisterFilterFunction(&path.FilterFunction{
+			Name:       "has_prefix",
+			Parameters: []path.FilterFunctionType{path.FilterFunctionTypeValue, path.FilterFunctionTypeValue},
+			Result:     path.FilterFunctionTypeLogical,
+			Evaluate: func(arguments []path.FilterFunctionValue) path.FilterFunctionValue {
+				result := path.FilterFunctionValue{Type: path.FilterFunctionTypeLogical}
+				str, prefix := indirectValue(arguments[0].Value), indirectValue(arguments[1].Value)
+				if str.Kind() == reflect.String && prefix.Kind() == reflect.String {
+					result.Logical = len(str.String()) >= len(prefix.String()) && str.String()[:len(prefix.String())] == prefix.String()
+				}
+				return result
+			},
+		}); err != nil {
+			t.Fatal("register has_prefix failed, err=", err)
+		}
+	}
+
+	for testData := range FilterFunctionsTestData {
+		res := make([]any, 0)
+		NewObject().WithSourceInterface(testData.Object).ForEach(testData.Path, func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+			res = append(res, value.Interface())
+			return false
+		})
+
+		if !reflect.DeepEqual(res, testData.Expected) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected res to be equal to testData.Expected\n",
+				"path=", testData.Path, "\n",
+				"res=", core.JsonStringifyMust(res), "\n",
+				"JSON testData.Expected=", core.JsonStringifyMust(testData.Expected),
+			)
+		}
+	}
+}
+
+type FilterFunctionsData struct {
+	internal.TestData
+	Object   any
+	Path     path.JSONPath
+	Expected any
+}
+
+func FilterFunctionsTestData(yield func(data *FilterFunctionsData) bool) {
+	type Item struct {
+		SKU   string
+		Tags  [3]string
+		Sizes map[int]string
+	}
+
+	items := []*Item{
+		{SKU: "AB-100", Tags: [3]string{"a", "b", ""}, Sizes: map[int]string{38: "S", 40: "M"}},
+		{SKU: "CD-200", Tags: [3]string{"c"}, Sizes: map[int]string{42: "L"}},
+		{SKU: "xAB-300", Sizes: map[int]string{}},
+	}
+
+	testCaseIndex := 1
+	if !yield(
+		&FilterFunctionsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: length of slices in maps", testCaseIndex),
+			},
+			Object: map[string]any{
+				"users": []any{
+					map[string]any{"name": "Alice", "roles": []string{"admin", "dev", "ops"}},
+					map[string]any{"name": "Bob", "roles": []string{"dev"}},
+				},
+			},
+			Path:     "$.users[?length(@.roles) > 2].name",
+			Expected: []any{"Alice"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FilterFunctionsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: match on struct fields", testCaseIndex),
+			},
+			Object:   items,
+			Path:     "$[?match(@.SKU, 'AB-[0-9]+')].SKU",
+			Expected: []any{"AB-100"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FilterFunctionsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: search on struct fields", testCaseIndex),
+			},
+			Object:   items,
+			Path:     "$[?search(@.SKU, 'AB-[0-9]+')].SKU",
+			Expected: []any{"AB-100", "xAB-300"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FilterFunctionsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: count over map with non-string keys", testCaseIndex),
+			},
+			Object:   items,
+			Path:     "$[?count(@.Sizes.*) >= 1 && length(@.Sizes) < 2].SKU",
+			Expected: []any{"CD-200"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FilterFunctionsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: value and length on arrays", testCaseIndex),
+			},
+			Object:   items,
+			Path:     "$[?value(@.Tags[0]) == 'c' || length(@.Tags[1]) == 1].SKU",
+			Expected: []any{"AB-100", "CD-200"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FilterFunctionsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: registered function", testCaseIndex),
+			},
+			Object:   items,
+			Path:     "$[?has_prefix(@.SKU, 'CD') || !has_prefix(@.SKU, 'A')].SKU",
+			Expected: []any{"CD-200", "xAB-300"},
+		},
+	) {
+		return
+	}
+}
@@ -0,0 +1,247 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+// FlattenKeyStyle is how the keys of the map returned by Object.Flatten are written.
+type FlattenKeyStyle int
+
+const (
+	// FlattenKeyJSONPath writes keys as normalized JSONPaths e.g., `$['items'][0]['name']`. The root is `$`.
+	FlattenKeyJSONPath FlattenKeyStyle = iota
+	// FlattenKeyJSONPointer writes keys as JSON Pointers e.g., `/items/0/name`. The root is an empty string.
+	FlattenKeyJSONPointer
+	// FlattenKeyDotted writes keys as JSONPaths in dot notation without the root e.g., `items[0].name`. The root is an empty string.
+	FlattenKeyDotted
+)
+
+/*
+IfLeafFound is called for each leaf found by Object.Flatten.
+
+Parameters:
+  - jsonPath - Concrete path to the leaf made up of the root, keys, and indexes. Copy it if it is kept after the callback returns.
+  - value - leaf found.
+
+Returns the value to add to the flat map and false to leave the leaf out.
+*/
+type IfLeafFound func(jsonPath path.RecursiveDescentSegment, value reflect.Value) (any, bool)
+
+// FlattenOptions change the map returned by Object.Flatten. The zero value keys leaves by normalized JSONPath.
+type FlattenOptions struct {
+	KeyStyle FlattenKeyStyle
+
+	// Optional. Called for each leaf to transform or leave it out.
+	IfLeafFound IfLeafFound
+}
+
+/*
+Flatten returns the leaves in source keyed by their normalized JSONPath e.g., `$['items'][0]['name']`.
+
+See Object.Flatten.
+*/
+func Flatten(source any) map[string]any {
+	return NewObject().WithSourceInterface(source).Flatten(FlattenOptions{})
+}
+
+/*
+Flatten returns the leaves in `Object.source` keyed by their path.
+
+A leaf is a value that is not a map, array/slice, or struct e.g., a string or a nil pointer, or a map, array/slice, or struct without members.
+Empty collections are kept as leaves so that Object.Unflatten recreates them.
+
+Struct fields are keyed by their name resolved with `Object.fieldNameStrategy`.
+
+Example:
+
+	flat := obj.Flatten(FlattenOptions{KeyStyle: FlattenKeyDotted})
+	// map[string]any{"items[0].name": "pen", "tags": []string{}}
+*/
+func (n *Object) Flatten(options FlattenOptions) map[string]any {
+	flat := make(map[string]any)
+	n.flatten(n.source, path.RecursiveDescentSegment{{Key: path.JsonpathKeyRoot, IsKeyRoot: true}}, options, flat)
+	return flat
+}
+
+// flatten adds the leaves in currentValue to flat.
+func (n *Object) flatten(currentValue reflect.Value, currentPath path.RecursiveDescentSegment, options FlattenOptions, flat map[string]any) {
+	if members := getCollectionMembers(currentValue, n.fieldNameStrategy); len(members) > 0 {
+		for _, member := range members {
+			n.flatten(member.value, append(currentPath[:len(currentPath):len(currentPath)], member.segment), options, flat)
+		}
+		return
+	}
+
+	value := valueFoundInterface(currentValue)
+	if options.IfLeafFound != nil {
+		var ok bool
+		if value, ok = options.IfLeafFound(currentPath, currentValue); !ok {
+			return
+		}
+	}
+	flat[flattenKey(currentPath, options.KeyStyle)] = value
+}
+
+// flattenKey returns currentPath written in keyStyle.
+func flattenKey(currentPath path.RecursiveDescentSegment, keyStyle FlattenKeyStyle) string {
+	switch keyStyle {
+	case FlattenKeyJSONPointer:
+		// currentPath is made up of the root, keys, and non-negative indexes only hence it is always a valid JSON Pointer.
+		jsonPointer, _ := currentPath.JSONPointer()
+		return string(jsonPointer)
+	case FlattenKeyDotted:
+		return strings.TrimPrefix(strings.TrimPrefix(currentPath.String(), path.JsonpathKeyRoot), path.JsonpathDotNotation)
+	default:
+		return currentPath.NormalizedString()
+	}
+}
+
+/*
+Unflatten rebuilds a nested structure from flat, a map like the one returned by Flatten.
+
+dynamicSchema is used to recreate typed structs, maps, and slices. If it is nil, maps are created as core.JsonObject and slices as core.JsonArray.
+
+See Object.Unflatten.
+*/
+func Unflatten(flat map[string]any, dynamicSchema schema.Schema) (any, error) {
+	obj := NewObject().WithSchema(dynamicSchema)
+	if _, err := obj.Unflatten(flat); err != nil {
+		return nil, err
+	}
+	return obj.GetSourceInterface(), nil
+}
+
+/*
+Unflatten sets each value in flat in `Object.source` using Object.Set with its key as the path.
+
+Keys may be written in any FlattenKeyStyle i.e., JSONPaths, JSON Pointers, or JSONPaths in dot notation without the root.
+Keys are set in sorted order so that the result does not depend on the order of iteration of flat.
+
+Since a JSON Pointer does not distinguish between object members and array elements, a numeric reference token e.g., `0` in `/a/0` is only used
+as an array index if the value at `/a` in `Object.source` or `Object.schema` is an array/slice, or if the reference tokens of all keys under `/a`
+are the indexes 0 to n-1. Otherwise, it is used as a map key e.g., `{"/0/1": 2}` becomes `{"0": {"1": 2}}`.
+
+Returns the number of values set. Stops at the first value that cannot be set and returns an error with the key in core.Error.Data.
+*/
+func (n *Object) Unflatten(flat map[string]any) (uint64, error) {
+	const FunctionName = "Unflatten"
+
+	keys := make([]string, 0, len(flat))
+	for key := range flat {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	jsonPointerArrays := unflattenJSONPointerArrays(keys)
+
+	var noOfModifications uint64
+	for _, key := range keys {
+		var err error
+		if strings.HasPrefix(key, path.JsonPointerSeparator) {
+			var segment path.RecursiveDescentSegment
+			if segment, err = n.unflattenJSONPointer(key, jsonPointerArrays); err == nil {
+				_, err = n.set(path.RecursiveDescentSegments{segment}, reflect.ValueOf(flat[key]))
+			}
+		} else {
+			_, err = n.Set(unflattenPath(key), flat[key])
+		}
+		if err != nil {
+			return noOfModifications, NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("set value at %s failed", key)).WithNestedError(err).WithData(core.JsonObject{"Key": key})
+		}
+		noOfModifications++
+	}
+	return noOfModifications, nil
+}
+
+// unflattenPath returns key, written in any FlattenKeyStyle, as a path.JSONPath.
+func unflattenPath(key string) path.JSONPath {
+	switch {
+	case key == "", strings.HasPrefix(key, path.JsonpathKeyRoot), strings.HasPrefix(key, path.JsonPointerSeparator):
+		return path.JSONPath(key)
+	case strings.HasPrefix(key, path.JsonpathLeftBracket):
+		return path.JSONPath(path.JsonpathKeyRoot + key)
+	default:
+		return path.JSONPath(path.JsonpathKeyRoot + path.JsonpathDotNotation + key)
+	}
+}
+
+/*
+unflattenJSONPointer parses the JSON Pointer key and keeps the numeric reference tokens that are array indexes as such. The other ones are used as map keys.
+
+A reference token is an array index if the value at its parent in `Object.source` or `Object.schema` is an array/slice, or its parent is in jsonPointerArrays.
+*/
+func (n *Object) unflattenJSONPointer(key string, jsonPointerArrays map[string]bool) (path.RecursiveDescentSegment, error) {
+	segment, err := path.JSONPointer(key).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	referenceTokens := strings.Split(key, path.JsonPointerSeparator)
+	for i := 1; i < len(segment); i++ {
+		if !segment[i].IsIndex || jsonPointerArrays[strings.Join(referenceTokens[:i], path.JsonPointerSeparator)] || n.isArraySliceAt(segment[:i]) {
+			continue
+		}
+		segment[i] = &path.CollectionMemberSegment{Key: segment[i].Key, IsKey: true}
+	}
+	return segment, nil
+}
+
+// isArraySliceAt returns true if the value at currentPath in `Object.source`, or its schema in `Object.schema`, is an array/slice.
+func (n *Object) isArraySliceAt(currentPath path.RecursiveDescentSegment) bool {
+	if value, noOfResults, _ := n.get(path.RecursiveDescentSegments{currentPath}); noOfResults > 0 {
+		if value = indirectValue(value); value.IsValid() {
+			if _, ok := core.GetArraySliceValueType(value); ok {
+				return true
+			}
+		}
+	}
+
+	if n.schema != nil {
+		if currentSchema, err := schema.GetSchemaAtPathWithFieldNameStrategy(currentPath, n.schema, n.fieldNameStrategy); err == nil {
+			return currentSchema.Kind == reflect.Slice || currentSchema.Kind == reflect.Array
+		}
+	}
+	return false
+}
+
+/*
+unflattenJSONPointerArrays returns the parents, as JSON Pointers, of the reference tokens in the JSON Pointer keys that look like an array
+i.e., the reference tokens under the parent are the indexes 0 to n-1.
+*/
+func unflattenJSONPointerArrays(keys []string) map[string]bool {
+	children := make(map[string]map[string]bool)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, path.JsonPointerSeparator) {
+			continue
+		}
+		referenceTokens := strings.Split(key, path.JsonPointerSeparator)
+		for i := 1; i < len(referenceTokens); i++ {
+			parent := strings.Join(referenceTokens[:i], path.JsonPointerSeparator)
+			if children[parent] == nil {
+				children[parent] = make(map[string]bool)
+			}
+			children[parent][referenceTokens[i]] = true
+		}
+	}
+
+	jsonPointerArrays := make(map[string]bool)
+	for parent, referenceTokens := range children {
+		isArray := true
+		for i := 0; i < len(referenceTokens); i++ {
+			if !referenceTokens[strconv.Itoa(i)] {
+				isArray = false
+				break
+			}
+		}
+		jsonPointerArrays[parent] = isArray
+	}
+	return jsonPointerArrays
+}
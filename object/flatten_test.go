@@ -0,0 +1,257 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+func TestObject_Flatten(t *testing.T) {
+	for testData := range FlattenTestData {
+		flat := NewObject().WithSourceInterface(testData.Root).Flatten(testData.Options)
+		if !reflect.DeepEqual(flat, testData.ExpectedFlat) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"flat not equal to testData.ExpectedFlat\n",
+				"flat=", core.JsonStringifyMust(flat), "\n",
+				"testData.ExpectedFlat=", core.JsonStringifyMust(testData.ExpectedFlat),
+			)
+		}
+
+		if testData.Options.IfLeafFound != nil {
+			continue
+		}
+
+		unflattened, err := Unflatten(flat, testData.Schema)
+		if err != nil || !reflect.DeepEqual(unflattened, testData.Root) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"round trip not equal to testData.Root\n",
+				"unflattened=", core.JsonStringifyMust(unflattened), "\n",
+				"testData.Root=", core.JsonStringifyMust(testData.Root), "\n",
+				"err=", err,
+			)
+		}
+	}
+}
+
+type FlattenData struct {
+	internal.TestData
+	Root         any
+	Schema       schema.Schema
+	Options      FlattenOptions
+	ExpectedFlat map[string]any
+}
+
+func FlattenTestData(yield func(data *FlattenData) bool) {
+	testCaseIndex := 1
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Normalized JSONPath keys", testCaseIndex),
+			},
+			Root: map[string]any{
+				"store": map[string]any{
+					"name":  "corner",
+					"books": []any{map[string]any{"title": "a"}, "b"},
+				},
+				"it's": true,
+			},
+			ExpectedFlat: map[string]any{
+				"$['store']['name']":              "corner",
+				"$['store']['books'][0]['title']": "a",
+				"$['store']['books'][1]":          "b",
+				`$['it\'s']`:                      true,
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: JSON Pointer keys", testCaseIndex),
+			},
+			Root:    map[string]any{"a/b": map[string]any{"c": []any{1.0, 2.0}}},
+			Options: FlattenOptions{KeyStyle: FlattenKeyJSONPointer},
+			ExpectedFlat: map[string]any{
+				"/a~1b/c/0": 1.0,
+				"/a~1b/c/1": 2.0,
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: JSON Pointer keys with numeric map keys", testCaseIndex),
+			},
+			Root: map[string]any{
+				"0":   map[string]any{"1": 2},
+				"a.b": map[string]any{"c": []any{"x"}},
+			},
+			Options: FlattenOptions{KeyStyle: FlattenKeyJSONPointer},
+			ExpectedFlat: map[string]any{
+				"/0/1":     2,
+				"/a.b/c/0": "x",
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Dotted keys", testCaseIndex),
+			},
+			Root:    map[string]any{"items": []any{map[string]any{"name": "pen", "1st": 1}}},
+			Options: FlattenOptions{KeyStyle: FlattenKeyDotted},
+			ExpectedFlat: map[string]any{
+				"items[0].name":   "pen",
+				"items[0]['1st']": 1,
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Empty collections are leaves", testCaseIndex),
+			},
+			Root: map[string]any{
+				"tags":    []any{},
+				"meta":    map[string]any{},
+				"missing": nil,
+			},
+			ExpectedFlat: map[string]any{
+				"$['tags']":    []any{},
+				"$['meta']":    map[string]any{},
+				"$['missing']": nil,
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Empty root", testCaseIndex),
+			},
+			Root:         []any{},
+			ExpectedFlat: map[string]any{"$": []any{}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Typed struct recreated with schema", testCaseIndex),
+			},
+			Root: []*UserProfile{{Name: "Alice", Age: 30, Address: Address{Street: "Main", City: "Nairobi"}}},
+			Schema: &schema.DynamicSchemaNode{
+				Kind: reflect.Slice,
+				Type: reflect.TypeOf([]*UserProfile{}),
+				ChildNodesLinearCollectionElementsSchema: &schema.DynamicSchemaNode{
+					Kind:                    reflect.Pointer,
+					Type:                    reflect.TypeOf(&UserProfile{}),
+					ChildNodesPointerSchema: UserProfileSchema(),
+				},
+			},
+			Options: FlattenOptions{KeyStyle: FlattenKeyDotted},
+			ExpectedFlat: map[string]any{
+				"[0].Name":            "Alice",
+				"[0].Age":             30,
+				"[0].Address.Street":  "Main",
+				"[0].Address.City":    "Nairobi",
+				"[0].Address.ZipCode": (*string)(nil),
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&FlattenData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Leaf callback transforms and leaves out values", testCaseIndex),
+			},
+			Root: map[string]any{"name": "Alice", "password": "secret", "age": 30},
+			Options: FlattenOptions{
+				IfLeafFound: func(jsonPath path.RecursiveDescentSegment, value reflect.Value) (any, bool) {
+					if jsonPath[len(jsonPath)-1].Key == "password" {
+						return nil, false
+					}
+					if s, ok := value.Interface().(string); ok {
+						return strings.ToUpper(s), true
+					}
+					return value.Interface(), true
+				},
+			},
+			ExpectedFlat: map[string]any{
+				"$['name']": "ALICE",
+				"$['age']":  30,
+			},
+		},
+	) {
+		return
+	}
+}
+
+func TestObject_Unflatten(t *testing.T) {
+	profile := &UserProfile{Name: "Alice"}
+	obj := NewObject().WithSourceInterface(profile)
+	if noOfModifications, err := obj.Unflatten(map[string]any{"Age": "31", "/Address/City": "Nairobi"}); noOfModifications != 2 || err != nil {
+		t.Error("expected 2 modifications, got=", noOfModifications, "err=", err)
+	}
+	if profile.Age != 31 || profile.Address.City != "Nairobi" || profile.Name != "Alice" {
+		t.Error("expected profile to be updated, got=", core.JsonStringifyMust(profile))
+	}
+
+	if _, err := obj.Unflatten(map[string]any{"Unknown": 1}); !errors.Is(err, ErrObjectError) {
+		t.Error("expected error for unknown field, got err=", err)
+	}
+
+	if result, err := Unflatten(map[string]any{}, nil); result != nil || err != nil {
+		t.Error("expected nil result, got=", result, "err=", err)
+	}
+
+	source := map[string]any{"a": []any{"x", "y"}}
+	if _, err := NewObject().WithSourceInterface(source).Unflatten(map[string]any{"/a/1": "z", "/b/1": "z"}); err != nil || !reflect.DeepEqual(source, map[string]any{"a": []any{"x", "z"}, "b": map[string]any{"1": "z"}}) {
+		t.Error("expected numeric reference token to index existing slice only, got=", core.JsonStringifyMust(source), "err=", err)
+	}
+
+	if result, err := Unflatten(map[string]any{"/1": "5"}, &schema.DynamicSchemaNode{
+		Kind:                                     reflect.Slice,
+		Type:                                     reflect.TypeOf([]int{}),
+		ChildNodesLinearCollectionElementsSchema: &schema.DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)},
+	}); err != nil || !reflect.DeepEqual(result, []int{0, 5}) {
+		t.Error("expected numeric reference token to index slice in schema, got=", result, "err=", err)
+	}
+
+	if _, err := Unflatten(map[string]any{"/a~2": 1}, nil); !errors.Is(err, path.ErrJSONPointerError) {
+		t.Error("expected error for invalid JSON Pointer, got err=", err)
+	}
+}
@@ -17,15 +17,30 @@ Useful for the following JSONPath syntax:
   - Wildcard e.g., `$.One[*]`
   - Union selector e.g., `$.['One','Two','Three']`
   - Array selector e.g., `$.[1:6:2]`
+  - Filter selector e.g., `$.orders[?@.total > 100]`
 
 Parameters:
   - jsonPath
   - ifValueFoundInObject - Called when each value is found.
 */
 func (n *Object) ForEach(jsonPath path.JSONPath, ifValueFoundInObject IfValueFoundInObject) {
-	n.recursiveDescentSegments = jsonPath.Parse()
-	n.ifValueFoundInObject = ifValueFoundInObject
+	n.forEach(jsonPath.Parse(), ifValueFoundInObject)
+}
+
+// ForEachCompiled is like ForEach but uses a path that has already been compiled with path.Compile.
+func (n *Object) ForEachCompiled(compiledPath *path.CompiledPath, ifValueFoundInObject IfValueFoundInObject) {
+	n.forEach(compiledPath.Segments(), ifValueFoundInObject)
+}
 
+// forEach is the underlying implementation of ForEach that works with an already parsed path.
+func (n *Object) forEach(recursiveDescentSegments path.RecursiveDescentSegments, ifValueFoundInObject IfValueFoundInObject) {
+	t := n.newTraversal(recursiveDescentSegments)
+	t.ifValueFoundInObject = ifValueFoundInObject
+	t.forEach()
+}
+
+// forEach calls traversal.ifValueFoundInObject for every value in traversal.source found using traversal.recursiveDescentSegments as the guide.
+func (n *traversal) forEach() {
 	currentPathSegmentIndexes := internal.PathSegmentsIndexes{
 		CurrentRecursive: 0,
 		LastRecursive:    len(n.recursiveDescentSegments) - 1,
@@ -47,7 +62,7 @@ func (n *Object) ForEach(jsonPath path.JSONPath, ifValueFoundInObject IfValueFou
 }
 
 // recursiveForEachValue traverses the object and invokes the callback for every matching node.
-func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
+func (n *traversal) recursiveForEachValue(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive || currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
 		return false
 	}
@@ -67,7 +82,15 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 		return n.recursiveForEachValue(currentValue.Elem(), currentPathSegmentIndexes, currentPath)
 	}
 
+	if hasFilterSelector(recursiveSegment) {
+		var ok bool
+		if recursiveSegment, ok = n.resolveFilterSelector(currentValue, recursiveSegment, currentPath); !ok {
+			return false
+		}
+	}
+
 	if recursiveSegment.IsKeyRoot {
+		currentPath = append(currentPath, recursiveSegment)
 		if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 			if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 				return n.ifValueFoundInObject(currentPath, currentValue)
@@ -94,16 +117,17 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 
 	if mapKeyType, _, ok := core.GetMapKeyValueType(currentValue); ok {
 		if recursiveSegment.IsKey {
-			var mapKey any
-			if err := n.defaultConverter.Convert(recursiveSegment.Key, &schema.DynamicSchemaNode{Kind: mapKeyType.Kind(), Type: mapKeyType}, &mapKey); err != nil {
+			mapKey := reflect.New(mapKeyType).Elem()
+			if err := n.defaultConverter.ConvertReflect(reflect.ValueOf(recursiveSegment.Key), &schema.DynamicSchemaNode{Kind: mapKeyType.Kind(), Type: mapKeyType}, mapKey); err != nil {
 				return false
 			}
 
-			mapValue := currentValue.MapIndex(reflect.ValueOf(mapKey))
+			mapValue := currentValue.MapIndex(mapKey)
+			nextPathSegments := append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: recursiveSegment.Key})
 			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 					if mapValue.IsValid() {
-						return n.ifValueFoundInObject(append(currentPath, recursiveSegment), mapValue)
+						return n.ifValueFoundInObject(nextPathSegments, mapValue)
 					}
 					return false
 				}
@@ -115,7 +139,7 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 					LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 				}
 
-				return n.recursiveDescentForEachValue(mapValue, recursiveDescentIndexes, append(currentPath, recursiveSegment))
+				return n.recursiveDescentForEachValue(mapValue, recursiveDescentIndexes, nextPathSegments)
 			}
 
 			recursiveIndexes := internal.PathSegmentsIndexes{
@@ -124,7 +148,7 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 				CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
 				LastCollection:    currentPathSegmentIndexes.LastCollection,
 			}
-			return n.recursiveForEachValue(mapValue, recursiveIndexes, append(currentPath, recursiveSegment))
+			return n.recursiveForEachValue(mapValue, recursiveIndexes, nextPathSegments)
 		}
 
 		if recursiveSegment.IsKeyIndexAll || len(recursiveSegment.UnionSelector) > 0 {
@@ -133,12 +157,9 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 			selectorSliceElementPaths := make(path.RecursiveDescentSegment, 0)
 
 			if recursiveSegment.IsKeyIndexAll {
-				for _, valueKey := range currentValue.MapKeys() {
-					mapValue := currentValue.MapIndex(valueKey)
-					if mapValue.IsValid() {
-						selectorSlice = reflect.Append(selectorSlice, mapValue)
-						selectorSliceElementPaths = append(selectorSliceElementPaths, &path.CollectionMemberSegment{IsKey: true, Key: valueKey.String()})
-					}
+				for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+					selectorSlice = reflect.Append(selectorSlice, member.value)
+					selectorSliceElementPaths = append(selectorSliceElementPaths, member.segment)
 				}
 			} else {
 				for _, unionKey := range recursiveSegment.UnionSelector {
@@ -146,15 +167,15 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 						continue
 					}
 
-					var mapKey any
-					if err := n.defaultConverter.Convert(unionKey.Key, &schema.DynamicSchemaNode{Kind: reflect.ValueOf(unionKey.Key).Kind(), Type: reflect.ValueOf(unionKey.Key).Type()}, &mapKey); err != nil {
-						return false
+					mapKey := reflect.New(mapKeyType).Elem()
+					if err := n.defaultConverter.ConvertReflect(reflect.ValueOf(unionKey.Key), &schema.DynamicSchemaNode{Kind: mapKeyType.Kind(), Type: mapKeyType}, mapKey); err != nil {
+						continue
 					}
 
-					mapValue := currentValue.MapIndex(reflect.ValueOf(mapKey))
+					mapValue := currentValue.MapIndex(mapKey)
 					if mapValue.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, mapValue)
-						selectorSliceElementPaths = append(selectorSliceElementPaths, unionKey)
+						selectorSliceElementPaths = append(selectorSliceElementPaths, &path.CollectionMemberSegment{IsKey: true, Key: unionKey.Key})
 					}
 				}
 			}
@@ -167,12 +188,13 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 
 	if _, ok := core.GetArraySliceValueType(currentValue); ok {
 		if recursiveSegment.IsIndex {
-			if recursiveSegment.Index >= currentValue.Len() {
+			index, ok := path.NormalizeIndex(recursiveSegment.Index, currentValue.Len())
+			if !ok {
 				return false
 			}
 
-			sliceArrayElementValue := currentValue.Index(recursiveSegment.Index)
-			nextPathSegments := append(currentPath, recursiveSegment)
+			sliceArrayElementValue := currentValue.Index(index)
+			nextPathSegments := append(currentPath, &path.CollectionMemberSegment{IsIndex: true, Index: index})
 			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 					if sliceArrayElementValue.IsValid() {
@@ -217,45 +239,22 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 				}
 			} else if len(recursiveSegment.UnionSelector) > 0 {
 				for _, unionKey := range recursiveSegment.UnionSelector {
-					if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() {
+					if !unionKey.IsIndex {
+						continue
+					}
+					index, ok := path.NormalizeIndex(unionKey.Index, currentValue.Len())
+					if !ok {
 						continue
 					}
 
-					sliceArrayValue := currentValue.Index(unionKey.Index)
+					sliceArrayValue := currentValue.Index(index)
 					if sliceArrayValue.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, sliceArrayValue)
-						selectorSliceElementPaths = append(selectorSliceElementPaths, unionKey)
+						selectorSliceElementPaths = append(selectorSliceElementPaths, &path.CollectionMemberSegment{IsIndex: true, Index: index})
 					}
 				}
 			} else {
-				start := 0
-				if recursiveSegment.LinearCollectionSelector.IsStart {
-					if recursiveSegment.LinearCollectionSelector.Start >= currentValue.Len() {
-						return false
-					}
-					start = recursiveSegment.LinearCollectionSelector.Start
-				}
-				step := 1
-				if recursiveSegment.LinearCollectionSelector.IsStep {
-					if recursiveSegment.LinearCollectionSelector.Step >= currentValue.Len() {
-						return false
-					}
-					if recursiveSegment.LinearCollectionSelector.Step > 0 {
-						step = recursiveSegment.LinearCollectionSelector.Step
-					}
-				}
-				end := currentValue.Len()
-				if recursiveSegment.LinearCollectionSelector.IsEnd {
-					if recursiveSegment.LinearCollectionSelector.End >= end {
-						return false
-					}
-					end = recursiveSegment.LinearCollectionSelector.End
-				}
-
-				for i := start; i < end; i += step {
-					if i >= currentValue.Len() {
-						continue
-					}
+				for _, i := range recursiveSegment.LinearCollectionSelector.Indices(currentValue.Len()) {
 					sliceArrayValue := currentValue.Index(i)
 					if sliceArrayValue.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, sliceArrayValue)
@@ -272,12 +271,12 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 
 	if currentValue.Kind() == reflect.Struct {
 		if recursiveSegment.IsKey {
-			if !core.StartsWithCapital(recursiveSegment.Key) {
+			structFieldValue, structFieldName, ok := n.structField(currentValue, recursiveSegment.Key)
+			if !ok {
 				return false
 			}
 
-			structFieldValue := currentValue.FieldByName(recursiveSegment.Key)
-			nextPathSegments := append(currentPath, recursiveSegment)
+			nextPathSegments := append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structFieldName})
 			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 					if structFieldValue.IsValid() {
@@ -313,27 +312,23 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 			selectorSliceElementPaths := make(path.RecursiveDescentSegment, 0)
 
 			if recursiveSegment.IsKeyIndexAll {
-				for i := 0; i < currentValue.NumField(); i++ {
-					if !core.IsStructFieldExported(currentValue.Type().Field(i)) {
-						continue
-					}
-
-					structField := currentValue.Field(i)
+				for _, structFieldName := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+					structField := structFieldName.Value(currentValue)
 					if structField.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, structField)
-						selectorSliceElementPaths = append(selectorSliceElementPaths, &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name})
+						selectorSliceElementPaths = append(selectorSliceElementPaths, &path.CollectionMemberSegment{IsKey: true, Key: structFieldName.Name})
 					}
 				}
 			} else {
 				for _, unionKey := range recursiveSegment.UnionSelector {
-					if !unionKey.IsKey || !core.StartsWithCapital(unionKey.Key) {
+					if !unionKey.IsKey {
 						continue
 					}
 
-					valueFromStruct := currentValue.FieldByName(unionKey.Key)
-					if valueFromStruct.IsValid() {
+					valueFromStruct, structFieldName, ok := n.structField(currentValue, unionKey.Key)
+					if ok && valueFromStruct.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, valueFromStruct)
-						selectorSliceElementPaths = append(selectorSliceElementPaths, unionKey)
+						selectorSliceElementPaths = append(selectorSliceElementPaths, &path.CollectionMemberSegment{IsKey: true, Key: structFieldName})
 					}
 				}
 			}
@@ -348,7 +343,7 @@ func (n *Object) recursiveForEachValue(currentValue reflect.Value, currentPathSe
 }
 
 // selectorForEachLoop handles iteration for selector segments (e.g. [*], [1,2]) within a ForEach operation.
-func (n *Object) selectorForEachLoop(selectorSlice reflect.Value, selectorSliceElementPaths path.RecursiveDescentSegment, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
+func (n *traversal) selectorForEachLoop(selectorSlice reflect.Value, selectorSliceElementPaths path.RecursiveDescentSegment, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
 	if selectorSlice.Len() == 0 {
 		return false
 	}
@@ -396,7 +391,7 @@ func (n *Object) selectorForEachLoop(selectorSlice reflect.Value, selectorSliceE
 }
 
 // recursiveDescentForEachValue handles ForEach traversal when recursive descent ('..') is involved.
-func (n *Object) recursiveDescentForEachValue(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
+func (n *traversal) recursiveDescentForEachValue(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive || currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
 		return false
 	}
@@ -412,26 +407,22 @@ func (n *Object) recursiveDescentForEachValue(currentValue reflect.Value, curren
 	}
 
 	if recursiveDescentSearchSegment.IsKeyRoot {
-		return n.recursiveForEachValue(currentValue, currentPathSegmentIndexes, append(currentPath, recursiveDescentSearchSegment))
-	}
-
-	if !recursiveDescentSearchSegment.IsKey {
-		return false
+		return n.recursiveForEachValue(currentValue, currentPathSegmentIndexes, currentPath)
 	}
 
 	if currentValue.Kind() == reflect.Pointer || currentValue.Kind() == reflect.Interface {
 		return n.recursiveDescentForEachValue(currentValue.Elem(), currentPathSegmentIndexes, currentPath)
 	}
 
-	if _, _, ok := core.GetMapKeyValueType(currentValue); ok {
-		for _, valueKey := range currentValue.MapKeys() {
-			mapEntryValue := currentValue.MapIndex(valueKey)
-			if !mapEntryValue.IsValid() {
-				continue
-			}
+	if !recursiveDescentSearchSegment.IsKey {
+		return n.recursiveDescentSelectorForEachValue(currentValue, currentPathSegmentIndexes, currentPath)
+	}
 
-			nextPathSegments := append(currentPath, recursiveDescentSearchSegment)
-			if valueKey.Interface() == recursiveDescentSearchSegment.Key {
+	if _, _, ok := core.GetMapKeyValueType(currentValue); ok {
+		for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+			mapEntryValue := member.value
+			nextPathSegments := append(currentPath, member.segment)
+			if mapKeyString(member.mapKey) == recursiveDescentSearchSegment.Key {
 				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 						if n.ifValueFoundInObject(nextPathSegments, mapEntryValue) {
@@ -481,53 +472,47 @@ func (n *Object) recursiveDescentForEachValue(currentValue reflect.Value, curren
 			}
 		}
 	} else if currentValue.Kind() == reflect.Struct {
-		if core.StartsWithCapital(recursiveDescentSearchSegment.Key) {
-			if structFieldValue := currentValue.FieldByName(recursiveDescentSearchSegment.Key); structFieldValue.IsValid() {
-				nextPathSegments := append(currentPath, recursiveDescentSearchSegment)
+		if structFieldValue, structFieldName, ok := n.structField(currentValue, recursiveDescentSearchSegment.Key); ok && structFieldValue.IsValid() {
+			nextPathSegments := append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structFieldName})
 
-				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
-					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						if n.ifValueFoundInObject(nextPathSegments, structFieldValue) {
-							return true
-						}
-					} else {
-						recursiveDescentIndexes := internal.PathSegmentsIndexes{
-							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
-							LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-							CurrentCollection: 0,
-							LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
-						}
-
-						if n.recursiveDescentForEachValue(structFieldValue, recursiveDescentIndexes, nextPathSegments) {
-							return true
-						}
+			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
+				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
+					if n.ifValueFoundInObject(nextPathSegments, structFieldValue) {
+						return true
 					}
 				} else {
-					recursiveIndexes := internal.PathSegmentsIndexes{
-						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					recursiveDescentIndexes := internal.PathSegmentsIndexes{
+						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
 						LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-						CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
-						LastCollection:    currentPathSegmentIndexes.LastCollection,
+						CurrentCollection: 0,
+						LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 					}
 
-					if n.recursiveForEachValue(structFieldValue, recursiveIndexes, nextPathSegments) {
+					if n.recursiveDescentForEachValue(structFieldValue, recursiveDescentIndexes, nextPathSegments) {
 						return true
 					}
 				}
-			}
-		}
+			} else {
+				recursiveIndexes := internal.PathSegmentsIndexes{
+					CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+					CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
+					LastCollection:    currentPathSegmentIndexes.LastCollection,
+				}
 
-		for i := 0; i < currentValue.NumField(); i++ {
-			if !core.IsStructFieldExported(currentValue.Type().Field(i)) {
-				continue
+				if n.recursiveForEachValue(structFieldValue, recursiveIndexes, nextPathSegments) {
+					return true
+				}
 			}
+		}
 
-			structFieldValue := currentValue.Field(i)
+		for _, structField := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+			structFieldValue := structField.Value(currentValue)
 			if !structFieldValue.IsValid() {
 				continue
 			}
 
-			if n.recursiveDescentForEachValue(structFieldValue, currentPathSegmentIndexes, currentPath) {
+			if n.recursiveDescentForEachValue(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structField.Name})) {
 				return true
 			}
 		}
@@ -535,3 +520,24 @@ func (n *Object) recursiveDescentForEachValue(currentValue reflect.Value, curren
 
 	return false
 }
+
+/*
+recursiveDescentSelectorForEachValue handles a recursive descent search segment that is not a key e.g., `$..[?@.price < 10]` or `$..[0]`.
+
+The selector is applied to currentValue and then to each of its descendants.
+*/
+func (n *traversal) recursiveDescentSelectorForEachValue(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) bool {
+	if isCollection(currentValue) {
+		if n.recursiveForEachValue(currentValue, currentPathSegmentIndexes, currentPath) {
+			return true
+		}
+	}
+
+	for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+		if n.recursiveDescentForEachValue(member.value, currentPathSegmentIndexes, append(currentPath, member.segment)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -301,4 +301,124 @@ func ForEachValueTestData(yield func(data *ForEachData) bool) {
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector", testCaseIndex),
+			},
+			Object: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 1, "status": "open", "total": 150},
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+					map[string]any{"id": 4, "status": "open", "total": 120.5},
+				},
+			},
+			Path:     "$.orders[?(@.status == 'open' && @.total > 100)].total",
+			Expected: []any{150, 120.5},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector in recursive descent", testCaseIndex),
+			},
+			Object: map[string]any{
+				"a": []any{1, 5, map[string]any{"b": []any{7, 2}}},
+			},
+			Path:     "$..[?@ > 3]",
+			Expected: []any{5, 7},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative index and negative step slice", testCaseIndex),
+			},
+			Object: map[string]any{
+				"a": [4]int{1, 2, 3, 4},
+				"b": []any{"x", "y"},
+			},
+			Path:     "$['a','b'][-1]",
+			Expected: []any{4, "y"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative step slice", testCaseIndex),
+			},
+			Object:   [4]int{1, 2, 3, 4},
+			Path:     "$[-2::-1]",
+			Expected: []any{3, 2, 1},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter and union over map with int keys", testCaseIndex),
+			},
+			Object: map[int]any{
+				1: map[string]any{"a": 5},
+				2: map[string]any{"a": 0},
+				3: "x",
+			},
+			Path:     "$[?@.a > 1]",
+			Expected: []any{map[string]any{"a": 5}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Union over map with int keys", testCaseIndex),
+			},
+			Object:   map[int]any{1: "x", 2: "y", 3: "z"},
+			Path:     "$['3','1','4']",
+			Expected: []any{"z", "x"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ForEachData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Root in nested filter query", testCaseIndex),
+			},
+			Object: map[string]any{
+				"x": 1,
+				"outer": []any{
+					map[string]any{"inner": []any{map[string]any{"y": 1}}},
+					map[string]any{"inner": []any{map[string]any{"y": 2}}},
+				},
+			},
+			Path:     "$.outer[?@.inner[?@.y == $.x]]",
+			Expected: []any{map[string]any{"inner": []any{map[string]any{"y": 1}}}},
+		},
+	) {
+		return
+	}
 }
@@ -18,44 +18,72 @@ For JSONPath syntax patterns like the recursive descent pattern, wildcard, or un
 Parameters:
   - jsonPath
 
-Returns the number of results found and the last error encountered.
+Returns the value found in Go form, the number of results found, and the last error encountered.
+If no value was found, the value returned is nil.
 */
-func (n *Object) Get(jsonPath path.JSONPath) (uint64, error) {
-	if string(jsonPath) == path.JsonpathKeyRoot || jsonPath == "" {
-		n.valueFound = n.source
-		return 1, nil
+func (n *Object) Get(jsonPath path.JSONPath) (any, uint64, error) {
+	valueFound, noOfResults, err := n.GetReflect(jsonPath)
+	return valueFoundInterface(valueFound), noOfResults, err
+}
+
+// GetReflect is like Get but returns the value found in reflect form. If no value was found, the value returned is not valid.
+func (n *Object) GetReflect(jsonPath path.JSONPath) (reflect.Value, uint64, error) {
+	if jsonPath == "" {
+		return n.source, 1, nil
 	}
 
-	const FunctionName = "Get"
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return reflect.Value{}, 0, err
+	}
+	return n.get(recursiveDescentSegments)
+}
 
-	n.recursiveDescentSegments = jsonPath.Parse()
+// GetCompiled is like Get but uses a path that has already been compiled with path.Compile.
+func (n *Object) GetCompiled(compiledPath *path.CompiledPath) (any, uint64, error) {
+	valueFound, noOfResults, err := n.get(compiledPath.Segments())
+	return valueFoundInterface(valueFound), noOfResults, err
+}
+
+// GetReflectCompiled is like GetReflect but uses a path that has already been compiled with path.Compile.
+func (n *Object) GetReflectCompiled(compiledPath *path.CompiledPath) (reflect.Value, uint64, error) {
+	return n.get(compiledPath.Segments())
+}
+
+// get is the underlying implementation of Get that works with an already parsed path.
+func (n *Object) get(recursiveDescentSegments path.RecursiveDescentSegments) (reflect.Value, uint64, error) {
+	if isRootPath(recursiveDescentSegments) {
+		return n.source, 1, nil
+	}
+
+	const FunctionName = "Get"
 
 	currentPathSegmentIndexes := internal.PathSegmentsIndexes{
-		LastRecursive: len(n.recursiveDescentSegments) - 1,
+		LastRecursive: len(recursiveDescentSegments) - 1,
 	}
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive {
-		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.source.Interface()})
+		return reflect.Value{}, 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
 	}
-	currentPathSegmentIndexes.LastCollection = len(n.recursiveDescentSegments[0]) - 1
+	currentPathSegmentIndexes.LastCollection = len(recursiveDescentSegments[0]) - 1
 	if currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
-		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.source.Interface()})
+		return reflect.Value{}, 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
 	}
 
+	t := n.newTraversal(recursiveDescentSegments)
+	var valueFound reflect.Value
 	if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-		n.valueFound = n.recursiveGet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]})
+		valueFound = t.recursiveGet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]})
 	} else {
-		n.valueFound = n.recursiveDescentGet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]})
+		valueFound = t.recursiveDescentGet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]})
 	}
 
-	if n.noOfResults > 0 {
-		return n.noOfResults, nil
-	}
-	return n.noOfResults, n.lastError
+	noOfResults, err := t.result()
+	return valueFound, noOfResults, err
 }
 
 // recursiveGet traverses the object structure linearly (without recursive descent '..').
 // It handles standard path segments like keys, indices, and selectors.
-func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+func (n *traversal) recursiveGet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
 	const FunctionName = "recursiveGet"
 
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive || currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
@@ -88,6 +116,13 @@ func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentInde
 		return n.recursiveGet(currentValue.Elem(), currentPathSegmentIndexes, currentPath)
 	}
 
+	if hasFilterSelector(recursiveSegment) {
+		var ok bool
+		if recursiveSegment, ok = n.resolveFilterSelector(currentValue, recursiveSegment, currentPath); !ok {
+			return reflect.Value{}
+		}
+	}
+
 	if recursiveSegment.IsKeyRoot {
 		if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 			if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
@@ -200,14 +235,15 @@ func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentInde
 		const dataKind = "array/slice"
 
 		if recursiveSegment.IsIndex {
-			if recursiveSegment.Index >= currentValue.Len() {
+			index, ok := path.NormalizeIndex(recursiveSegment.Index, currentValue.Len())
+			if !ok {
 				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in %s, index %s out of range", dataKind, recursiveSegment)).
 					WithNestedError(ErrValueAtPathSegmentInvalidError).
 					WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
 				return reflect.Value{}
 			}
 
-			arraySliceValue := currentValue.Index(recursiveSegment.Index)
+			arraySliceValue := currentValue.Index(index)
 			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 					if arraySliceValue.IsValid() {
@@ -251,53 +287,29 @@ func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentInde
 				}
 			} else if len(recursiveSegment.UnionSelector) > 0 {
 				for _, unionKey := range recursiveSegment.UnionSelector {
-					if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() {
+					if !unionKey.IsIndex {
+						continue
+					}
+					index, ok := path.NormalizeIndex(unionKey.Index, currentValue.Len())
+					if !ok {
 						continue
 					}
 
-					valueFromSliceArray := currentValue.Index(unionKey.Index)
+					valueFromSliceArray := currentValue.Index(index)
 					if valueFromSliceArray.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, valueFromSliceArray)
 					}
 				}
 			} else {
-				start := 0
-				if recursiveSegment.LinearCollectionSelector.IsStart {
-					if recursiveSegment.LinearCollectionSelector.Start >= currentValue.Len() {
-						n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in %s, linear collection selector %s Start is out of range", dataKind, recursiveSegment)).
-							WithNestedError(ErrPathSegmentInvalidError).
-							WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
-						return reflect.Value{}
-					}
-					start = recursiveSegment.LinearCollectionSelector.Start
-				}
-				step := 1
-				if recursiveSegment.LinearCollectionSelector.IsStep {
-					if recursiveSegment.LinearCollectionSelector.Step >= currentValue.Len() {
-						n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in %s, linear collection selector %s Step is out of range", dataKind, recursiveSegment)).
-							WithNestedError(ErrPathSegmentInvalidError).
-							WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
-						return reflect.Value{}
-					}
-					if recursiveSegment.LinearCollectionSelector.Step > 0 {
-						step = recursiveSegment.LinearCollectionSelector.Step
-					}
-				}
-				end := currentValue.Len()
-				if recursiveSegment.LinearCollectionSelector.IsEnd {
-					if recursiveSegment.LinearCollectionSelector.End >= end {
-						n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in %s, linear collection selector %s End is out of range", dataKind, recursiveSegment)).
-							WithNestedError(ErrPathSegmentInvalidError).
-							WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
-						return reflect.Value{}
-					}
-					end = recursiveSegment.LinearCollectionSelector.End
+				indices := recursiveSegment.LinearCollectionSelector.Indices(currentValue.Len())
+				if len(indices) == 0 {
+					n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in %s, linear collection selector %s selected no elements", dataKind, recursiveSegment)).
+						WithNestedError(ErrValueAtPathSegmentInvalidError).
+						WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
+					return reflect.Value{}
 				}
 
-				for i := start; i < end; i += step {
-					if i >= currentValue.Len() {
-						continue
-					}
+				for _, i := range indices {
 					valueFromSliceArray := currentValue.Index(i)
 					if valueFromSliceArray.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, valueFromSliceArray)
@@ -318,14 +330,13 @@ func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentInde
 		const dataKind = "struct"
 
 		if recursiveSegment.IsKey {
-			if !core.StartsWithCapital(recursiveSegment.Key) {
-				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("key %s is not valid for struct", recursiveSegment)).
+			structFieldValue, _, ok := n.structField(currentValue, recursiveSegment.Key)
+			if !ok {
+				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(n.invalidStructFieldMessage(currentValue, recursiveSegment)).
 					WithNestedError(ErrPathSegmentInvalidError).
 					WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
 				return reflect.Value{}
 			}
-
-			structFieldValue := currentValue.FieldByName(recursiveSegment.Key)
 			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
 					if structFieldValue.IsValid() {
@@ -363,24 +374,19 @@ func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentInde
 			selectorSlice := reflect.MakeSlice(reflect.TypeOf(_sliceAny), 0, 0)
 
 			if recursiveSegment.IsKeyIndexAll {
-				for i := 0; i < currentValue.NumField(); i++ {
-					if !core.IsStructFieldExported(currentValue.Type().Field(i)) {
-						continue
-					}
-
-					structFieldValue := currentValue.Field(i)
+				for _, structField := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+					structFieldValue := structField.Value(currentValue)
 					if structFieldValue.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, structFieldValue)
 					}
 				}
 			} else {
 				for _, unionKey := range recursiveSegment.UnionSelector {
-					if !unionKey.IsKey || !core.StartsWithCapital(unionKey.Key) {
+					if !unionKey.IsKey {
 						continue
 					}
 
-					structFieldValue := currentValue.FieldByName(unionKey.Key)
-					if structFieldValue.IsValid() {
+					if structFieldValue, _, ok := n.structField(currentValue, unionKey.Key); ok && structFieldValue.IsValid() {
 						selectorSlice = reflect.Append(selectorSlice, structFieldValue)
 					}
 				}
@@ -403,7 +409,7 @@ func (n *Object) recursiveGet(currentValue reflect.Value, currentPathSegmentInde
 
 // selectorGetLoop iterates over a collection of values (found via a selector like [*] or [1,2])
 // and continues the traversal for each element.
-func (n *Object) selectorGetLoop(dataKind string, selectorSlice reflect.Value, recursiveSegment *path.CollectionMemberSegment, currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+func (n *traversal) selectorGetLoop(dataKind string, selectorSlice reflect.Value, recursiveSegment *path.CollectionMemberSegment, currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
 	const FunctionName = "selectorGetLoop"
 	_sliceAny := make([]any, 0)
 
@@ -457,7 +463,7 @@ func (n *Object) selectorGetLoop(dataKind string, selectorSlice reflect.Value, r
 
 // recursiveDescentGet handles the recursive descent operator ('..').
 // It searches for the target key at the current level and all nested levels.
-func (n *Object) recursiveDescentGet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+func (n *traversal) recursiveDescentGet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
 	const FunctionName = "recursiveDescentGet"
 
 	var valueFound reflect.Value
@@ -496,17 +502,14 @@ func (n *Object) recursiveDescentGet(currentValue reflect.Value, currentPathSegm
 		return n.recursiveGet(currentValue, currentPathSegmentIndexes, currentPath)
 	}
 
-	if !recursiveDescentSearchSegment.IsKey {
-		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("recursive descent search segment %s is not key", recursiveDescentSearchSegment)).
-			WithNestedError(ErrPathSegmentInvalidError).
-			WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
-		return reflect.Value{}
-	}
-
 	if currentValue.Kind() == reflect.Pointer || currentValue.Kind() == reflect.Interface {
 		return n.recursiveDescentGet(currentValue.Elem(), currentPathSegmentIndexes, currentPath)
 	}
 
+	if !recursiveDescentSearchSegment.IsKey {
+		return n.recursiveDescentSelectorGet(currentValue, currentPathSegmentIndexes, currentPath)
+	}
+
 	if _, _, ok := core.GetMapKeyValueType(currentValue); ok {
 		for _, mapKey := range currentValue.MapKeys() {
 			mapValue := currentValue.MapIndex(mapKey)
@@ -601,70 +604,64 @@ func (n *Object) recursiveDescentGet(currentValue reflect.Value, currentPathSegm
 			}
 		}
 	} else if currentValue.Kind() == reflect.Struct {
-		if core.StartsWithCapital(recursiveDescentSearchSegment.Key) {
-			if structFieldValue := currentValue.FieldByName(recursiveDescentSearchSegment.Key); structFieldValue.IsValid() {
-				nextPathSegments := append(currentPath, recursiveDescentSearchSegment)
-				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
-					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						valueFound = reflect.Append(valueFound, structFieldValue)
-					} else {
-						recursiveDescentIndexes := internal.PathSegmentsIndexes{
-							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
-							LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-							CurrentCollection: 0,
-							LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
-						}
-
-						recursiveDescentValue := n.recursiveDescentGet(structFieldValue, recursiveDescentIndexes, nextPathSegments)
-						if recursiveDescentValue.IsValid() {
-							if recursiveDescentValue.Kind() == reflect.Slice {
-								for i := 0; i < recursiveDescentValue.Len(); i++ {
-									valueFound = reflect.Append(valueFound, recursiveDescentValue.Index(i))
-								}
-							} else {
-								valueFound = reflect.Append(valueFound, recursiveDescentValue)
-							}
-						} else {
-							n.noOfResults = uint64(valueFound.Len())
-							return valueFound
-						}
-					}
+		if structFieldValue, _, ok := n.structField(currentValue, recursiveDescentSearchSegment.Key); ok && structFieldValue.IsValid() {
+			nextPathSegments := append(currentPath, recursiveDescentSearchSegment)
+			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
+				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
+					valueFound = reflect.Append(valueFound, structFieldValue)
 				} else {
-					recursiveIndexes := internal.PathSegmentsIndexes{
-						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					recursiveDescentIndexes := internal.PathSegmentsIndexes{
+						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
 						LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-						CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
-						LastCollection:    currentPathSegmentIndexes.LastCollection,
+						CurrentCollection: 0,
+						LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 					}
 
-					recursiveValue := n.recursiveGet(structFieldValue, recursiveIndexes, nextPathSegments)
-					if recursiveValue.IsValid() {
-						if recursiveValue.Kind() == reflect.Slice || recursiveValue.Kind() == reflect.Array {
-							for i := 0; i < recursiveValue.Len(); i++ {
-								valueFound = reflect.Append(valueFound, recursiveValue.Index(i))
+					recursiveDescentValue := n.recursiveDescentGet(structFieldValue, recursiveDescentIndexes, nextPathSegments)
+					if recursiveDescentValue.IsValid() {
+						if recursiveDescentValue.Kind() == reflect.Slice {
+							for i := 0; i < recursiveDescentValue.Len(); i++ {
+								valueFound = reflect.Append(valueFound, recursiveDescentValue.Index(i))
 							}
 						} else {
-							valueFound = reflect.Append(valueFound, recursiveValue)
+							valueFound = reflect.Append(valueFound, recursiveDescentValue)
 						}
 					} else {
 						n.noOfResults = uint64(valueFound.Len())
 						return valueFound
 					}
 				}
-			}
-		}
+			} else {
+				recursiveIndexes := internal.PathSegmentsIndexes{
+					CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+					CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
+					LastCollection:    currentPathSegmentIndexes.LastCollection,
+				}
 
-		for i := 0; i < currentValue.NumField(); i++ {
-			if !core.IsStructFieldExported(currentValue.Type().Field(i)) {
-				continue
+				recursiveValue := n.recursiveGet(structFieldValue, recursiveIndexes, nextPathSegments)
+				if recursiveValue.IsValid() {
+					if recursiveValue.Kind() == reflect.Slice || recursiveValue.Kind() == reflect.Array {
+						for i := 0; i < recursiveValue.Len(); i++ {
+							valueFound = reflect.Append(valueFound, recursiveValue.Index(i))
+						}
+					} else {
+						valueFound = reflect.Append(valueFound, recursiveValue)
+					}
+				} else {
+					n.noOfResults = uint64(valueFound.Len())
+					return valueFound
+				}
 			}
+		}
 
-			structFieldValue := currentValue.Field(i)
+		for _, structField := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+			structFieldValue := structField.Value(currentValue)
 			if !structFieldValue.IsValid() {
 				continue
 			}
 
-			recursiveDescentValue := n.recursiveDescentGet(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name}))
+			recursiveDescentValue := n.recursiveDescentGet(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structField.Name}))
 			if recursiveDescentValue.IsValid() {
 				if recursiveDescentValue.Kind() == reflect.Slice {
 					for i := 0; i < recursiveDescentValue.Len(); i++ {
@@ -693,11 +690,61 @@ func (n *Object) recursiveDescentGet(currentValue reflect.Value, currentPathSegm
 	return valueFound
 }
 
-// convert nested slice result v from recursiveGet into a single 1D slice if the next pathSegment contains CollectionMemberSegment.IsKeyIndexAll, CollectionMemberSegment.UnionSelector, or CollectionMemberSegment.LinearCollectionSelector.
-func (n *Object) flattenNewSliceResult(newSliceResult reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, v reflect.Value) reflect.Value {
+/*
+recursiveDescentSelectorGet handles a recursive descent search segment that is not a key e.g., `$..[?@.price < 10]`, `$..[0]`, or `$..*`.
+
+The selector is applied to currentValue and then to each of its descendants.
+*/
+func (n *traversal) recursiveDescentSelectorGet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+	const FunctionName = "recursiveDescentSelectorGet"
+
+	var valueFound reflect.Value
+	{
+		_sliceAny := make([]any, 0)
+		valueFound = reflect.MakeSlice(reflect.TypeOf(_sliceAny), 0, 0)
+	}
+
+	recursiveDescentSearchSegment := n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]
+
+	if isCollection(currentValue) {
+		if recursiveValue := n.recursiveGet(currentValue, currentPathSegmentIndexes, currentPath); recursiveValue.IsValid() {
+			singleResult := recursiveDescentSearchSegment.IsIndex && currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection && currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive
+			if recursiveValue.Kind() == reflect.Slice && !singleResult {
+				for i := 0; i < recursiveValue.Len(); i++ {
+					valueFound = reflect.Append(valueFound, recursiveValue.Index(i))
+				}
+			} else {
+				valueFound = reflect.Append(valueFound, recursiveValue)
+			}
+		}
+	}
+
+	for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+		recursiveDescentValue := n.recursiveDescentGet(member.value, currentPathSegmentIndexes, append(currentPath, member.segment))
+		if recursiveDescentValue.IsValid() {
+			for i := 0; i < recursiveDescentValue.Len(); i++ {
+				valueFound = reflect.Append(valueFound, recursiveDescentValue.Index(i))
+			}
+		}
+	}
+
+	if valueFound.Len() == 0 {
+		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("no search value found at recursive descent search segment %s", recursiveDescentSearchSegment)).
+			WithNestedError(ErrObjectError).
+			WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
+		return reflect.Value{}
+	}
+
+	n.noOfResults = uint64(valueFound.Len())
+	return valueFound
+}
+
+// convert nested slice result v from recursiveGet into a single 1D slice if the next pathSegment contains CollectionMemberSegment.IsKeyIndexAll, CollectionMemberSegment.UnionSelector, CollectionMemberSegment.LinearCollectionSelector, or CollectionMemberSegment.FilterSelector.
+func (n *traversal) flattenNewSliceResult(newSliceResult reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, v reflect.Value) reflect.Value {
 	if currentPathSegmentIndexes.CurrentCollection < currentPathSegmentIndexes.LastCollection {
 		if v.Kind() == reflect.Slice {
-			if n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection+1].IsKeyIndexAll || len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection+1].UnionSelector) > 0 || n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection+1].LinearCollectionSelector != nil {
+			nextPathSegment := n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection+1]
+			if nextPathSegment.IsKeyIndexAll || len(nextPathSegment.UnionSelector) > 0 || nextPathSegment.LinearCollectionSelector != nil || nextPathSegment.FilterSelector != nil {
 				for i := 0; i < v.Len(); i++ {
 					newSliceResult = reflect.Append(newSliceResult, v.Index(i))
 				}
@@ -0,0 +1,67 @@
+package object
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+/*
+QueryResult is a value found in `Object.source` together with the concrete path to it.
+
+Path only contains the root, keys, and indexes e.g., `$['store']['book'][2]['title']`, hence it can be passed back to Object.Set or Object.Delete
+using `path.JSONPath(result.Path.NormalizedString())`.
+*/
+type QueryResult struct {
+	Path  path.RecursiveDescentSegment
+	Value reflect.Value
+}
+
+/*
+GetAll retrieves every value in `Object.source` at jsonPath along with its normalized path.
+
+Unlike Get, values found through the recursive descent pattern, wildcard, union, array, or filter selectors e.g., `$..One`, `$.One[*]`, `$.orders[?@.total > 100]`
+are not flattened so the location of each value is preserved.
+
+Results are in the order they are found. Map entries are visited in the order of their keys.
+
+Parameters:
+  - jsonPath
+
+Returns an error if no value was found.
+*/
+func (n *Object) GetAll(jsonPath path.JSONPath) ([]QueryResult, error) {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return nil, err
+	}
+	return n.getAll(recursiveDescentSegments)
+}
+
+// GetAllCompiled is like GetAll but uses a path that has already been compiled with path.Compile.
+func (n *Object) GetAllCompiled(compiledPath *path.CompiledPath) ([]QueryResult, error) {
+	return n.getAll(compiledPath.Segments())
+}
+
+// getAll is the underlying implementation of GetAll that works with an already parsed path.
+func (n *Object) getAll(recursiveDescentSegments path.RecursiveDescentSegments) ([]QueryResult, error) {
+	const FunctionName = "GetAll"
+
+	results := make([]QueryResult, 0)
+	n.forEach(recursiveDescentSegments, func(currentPath path.RecursiveDescentSegment, value reflect.Value) bool {
+		results = append(results, QueryResult{Path: slices.Clone(currentPath), Value: value})
+		return false
+	})
+
+	if len(results) == 0 {
+		var source any
+		if n.source.IsValid() {
+			source = n.source.Interface()
+		}
+		return nil, NewError().WithFunctionName(FunctionName).WithMessage("no value found at path").WithNestedError(ErrValueAtPathSegmentInvalidError).WithData(core.JsonObject{"Source": source, "Path": recursiveDescentSegments.String()})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,299 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_GetAll(t *testing.T) {
+	for testData := range GetAllTestData {
+		results, err := NewObject().WithSourceInterface(testData.Object).GetAll(testData.Path)
+		if testData.ExpectedPaths == nil {
+			if err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+				t.Error(
+					testData.TestTitle, "\n",
+					"expected ErrValueAtPathSegmentInvalidError, got err=", err, "\n",
+					"path=", testData.Path,
+				)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error(testData.TestTitle, "\n", "expected no error, got err=", err, "\n", "path=", testData.Path)
+			continue
+		}
+
+		paths := make([]string, 0)
+		values := make([]any, 0)
+		for _, result := range results {
+			paths = append(paths, result.Path.NormalizedString())
+			values = append(values, result.Value.Interface())
+		}
+
+		if !reflect.DeepEqual(paths, testData.ExpectedPaths) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected paths to be equal to testData.ExpectedPaths\n",
+				"path=", testData.Path, "\n",
+				"paths=", core.JsonStringifyMust(paths), "\n",
+				"JSON testData.ExpectedPaths=", core.JsonStringifyMust(testData.ExpectedPaths),
+			)
+		}
+
+		if !reflect.DeepEqual(values, testData.ExpectedValues) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected values to be equal to testData.ExpectedValues\n",
+				"path=", testData.Path, "\n",
+				"values=", core.JsonStringifyMust(values), "\n",
+				"JSON testData.ExpectedValues=", core.JsonStringifyMust(testData.ExpectedValues),
+			)
+		}
+	}
+}
+
+func TestObject_GetAll_PathsRoundTrip(t *testing.T) {
+	source := map[string]any{
+		"orders": []any{
+			map[string]any{"id": 1, "total": 50, "status": "open"},
+			map[string]any{"id": 2, "total": 150, "status": "open"},
+			map[string]any{"id": 3, "total": 300, "status": "open"},
+		},
+	}
+	obj := NewObject().WithSourceInterface(source)
+
+	results, err := obj.GetAll("$..[?@.total > 100]")
+	if err != nil {
+		t.Fatal("GetAll failed, err=", err)
+	}
+
+	for _, result := range results {
+		if _, err := obj.Set(path.JSONPath(result.Path.NormalizedString()+"['status']"), "flagged"); err != nil {
+			t.Error("Set failed, path=", result.Path.NormalizedString(), "err=", err)
+		}
+	}
+
+	expected := map[string]any{
+		"orders": []any{
+			map[string]any{"id": 1, "total": 50, "status": "open"},
+			map[string]any{"id": 2, "total": 150, "status": "flagged"},
+			map[string]any{"id": 3, "total": 300, "status": "flagged"},
+		},
+	}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+		t.Error("expected source after Set to be equal to expected\n", "source=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+
+	// delete in reverse so that earlier indexes remain valid.
+	for i := len(results) - 1; i >= 0; i-- {
+		if _, err := obj.Delete(path.JSONPath(results[i].Path.NormalizedString())); err != nil {
+			t.Error("Delete failed, path=", results[i].Path.NormalizedString(), "err=", err)
+		}
+	}
+
+	expected = map[string]any{
+		"orders": []any{
+			map[string]any{"id": 1, "total": 50, "status": "open"},
+		},
+	}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+		t.Error("expected source after Delete to be equal to expected\n", "source=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+}
+
+func TestObject_GetAll_RecursiveDescentNonStringMapKeys(t *testing.T) {
+	type key string
+
+	for _, testData := range []struct {
+		source   any
+		jsonPath path.JSONPath
+	}{
+		{source: map[int]any{1: "x", 2: map[int]any{1: "y"}}, jsonPath: "$..['1']"},
+		{source: map[key]any{"k": "x", "a": map[key]any{"k": "y"}}, jsonPath: "$..k"},
+	} {
+		obj := NewObject().WithSourceInterface(testData.source)
+
+		valueFound, noOfResults, err := obj.Get(testData.jsonPath)
+		if err != nil {
+			t.Error("Get failed, path=", testData.jsonPath, "err=", err)
+		}
+		results, err := obj.GetAll(testData.jsonPath)
+		if err != nil {
+			t.Error("GetAll failed, path=", testData.jsonPath, "err=", err)
+		}
+
+		values := make([]any, 0, len(results))
+		for _, result := range results {
+			values = append(values, result.Value.Interface())
+		}
+		// Get iterates over maps in no particular order.
+		byString := func(a, b any) int {
+			return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		}
+		slices.SortFunc(values, byString)
+		sortedValuesFound := slices.SortedFunc(slices.Values(valueFound.([]any)), byString)
+		if noOfResults != uint64(len(results)) || !reflect.DeepEqual(values, sortedValuesFound) {
+			t.Error(
+				"expected GetAll to agree with Get, path=", testData.jsonPath, "\n",
+				"Get=", core.JsonStringifyMust(sortedValuesFound), "\n",
+				"GetAll=", core.JsonStringifyMust(values),
+			)
+		}
+		if len(results) != 2 {
+			t.Error("expected 2 results, path=", testData.jsonPath, "got=", core.JsonStringifyMust(values))
+		}
+	}
+}
+
+type GetAllData struct {
+	internal.TestData
+	Object         any
+	Path           path.JSONPath
+	ExpectedPaths  []string
+	ExpectedValues []any
+}
+
+func GetAllTestData(yield func(data *GetAllData) bool) {
+	store := map[string]any{
+		"store": map[string]any{
+			"book": []any{
+				map[string]any{"title": "Sayings of the Century", "price": 8.95},
+				map[string]any{"title": "Sword of Honour", "price": 12.99},
+				map[string]any{"title": "Moby Dick", "price": 8.99},
+			},
+			"bicycle": map[string]any{"color": "red", "price": 19.95},
+		},
+	}
+
+	testCaseIndex := 1
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: recursive descent", testCaseIndex),
+			},
+			Object: store,
+			Path:   "$..title",
+			ExpectedPaths: []string{
+				"$['store']['book'][0]['title']",
+				"$['store']['book'][1]['title']",
+				"$['store']['book'][2]['title']",
+			},
+			ExpectedValues: []any{"Sayings of the Century", "Sword of Honour", "Moby Dick"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: wildcard on map", testCaseIndex),
+			},
+			Object: store,
+			Path:   "$.store.*.price",
+			ExpectedPaths: []string{
+				"$['store']['bicycle']['price']",
+			},
+			ExpectedValues: []any{19.95},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: filter and negative index", testCaseIndex),
+			},
+			Object: store,
+			Path:   "$.store.book[?@.price < 10].title",
+			ExpectedPaths: []string{
+				"$['store']['book'][0]['title']",
+				"$['store']['book'][2]['title']",
+			},
+			ExpectedValues: []any{"Sayings of the Century", "Moby Dick"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: union and slice", testCaseIndex),
+			},
+			Object: store,
+			Path:   "$.store.book[-1,0]['title','price']",
+			ExpectedPaths: []string{
+				"$['store']['book'][2]['title']",
+				"$['store']['book'][2]['price']",
+				"$['store']['book'][0]['title']",
+				"$['store']['book'][0]['price']",
+			},
+			ExpectedValues: []any{"Moby Dick", 8.99, "Sayings of the Century", 8.95},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: structs and maps with non-string keys", testCaseIndex),
+			},
+			Object: []any{
+				ComplexData{User: User{Name: "Bob"}},
+				map[int]any{7: User{Name: "Alice"}},
+			},
+			Path: "$..Name",
+			ExpectedPaths: []string{
+				"$[0]['User']['Name']",
+				"$[1]['7']['Name']",
+			},
+			ExpectedValues: []any{"Bob", "Alice"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: keys that need escaping", testCaseIndex),
+			},
+			Object:         map[string]any{"it's": map[string]any{"a\\b": 1}},
+			Path:           `$["it's"].*`,
+			ExpectedPaths:  []string{`$['it\'s']['a\\b']`},
+			ExpectedValues: []any{1},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetAllData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: no match", testCaseIndex),
+			},
+			Object: store,
+			Path:   "$.store.book[?@.price > 100]",
+		},
+	) {
+		return
+	}
+}
@@ -0,0 +1,165 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+/*
+GetAs retrieves the value in the source of obj at jsonPath as type T.
+
+The value is found with Object.Get hence for the recursive descent pattern, wildcard, or union selector the value found is a slice of type any
+which can be converted to a slice e.g., `GetAs[[]int](obj, "$.items[*].quantity")`. Use GetAllAs to get each value found separately.
+
+Values that are not of type T are converted using `Object.defaultConverter` e.g., a float64 decoded from JSON to an int. A nil value becomes the zero value of T.
+
+Returns an error that wraps ErrValueAtPathSegmentInvalidError if no value was found or the value could not be converted to T.
+
+Example:
+
+	age, err := object.GetAs[int](obj, "$.users[0].age")
+*/
+func GetAs[T any](obj *Object, jsonPath path.JSONPath) (T, error) {
+	const FunctionName = "GetAs"
+
+	var result T
+
+	valueFound, noOfResults, err := obj.GetReflect(jsonPath)
+	if noOfResults == 0 {
+		return result, NewError().WithFunctionName(FunctionName).WithMessage("no value found at path").WithNestedError(errors.Join(ErrValueAtPathSegmentInvalidError, err)).WithData(core.JsonObject{"Path": string(jsonPath)})
+	}
+
+	result, err = convertValue[T](obj.defaultConverter, valueFound)
+	if err != nil {
+		return result, NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("convert value to %T failed", result)).WithNestedError(errors.Join(ErrValueAtPathSegmentInvalidError, err)).WithData(core.JsonObject{"Path": string(jsonPath)})
+	}
+
+	return result, nil
+}
+
+// GetAsOrDefault is like GetAs but returns defaultValue if no value was found at jsonPath or the value could not be converted to T.
+func GetAsOrDefault[T any](obj *Object, jsonPath path.JSONPath, defaultValue T) T {
+	if result, err := GetAs[T](obj, jsonPath); err == nil {
+		return result
+	}
+	return defaultValue
+}
+
+// MustGetAs is like GetAs but panics if an error is encountered. Meant for paths that are known to exist e.g., in tests.
+func MustGetAs[T any](obj *Object, jsonPath path.JSONPath) T {
+	result, err := GetAs[T](obj, jsonPath)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+/*
+GetAllAs retrieves every value in the source of obj at jsonPath as a slice of T.
+
+Unlike GetAs, values found through the recursive descent pattern, wildcard, union, array, or filter selectors are converted one by one.
+Results are in the order they are found. See Object.GetAll.
+
+Returns an error that wraps ErrValueAtPathSegmentInvalidError if no value was found or one of the values could not be converted to T.
+
+Example:
+
+	prices, err := object.GetAllAs[float64](obj, "$..price")
+*/
+func GetAllAs[T any](obj *Object, jsonPath path.JSONPath) ([]T, error) {
+	const FunctionName = "GetAllAs"
+
+	results := make([]T, 0)
+	iterator := IterateValues[T](obj, jsonPath)
+	for _, value := range iterator.All() {
+		results = append(results, value)
+	}
+
+	if err := iterator.Err(); err != nil {
+		return nil, NewError().WithFunctionName(FunctionName).WithMessage("get values failed").WithNestedError(err).WithData(core.JsonObject{"Path": string(jsonPath)})
+	}
+
+	return results, nil
+}
+
+// convertValue returns value as T converting it with defaultConverter if it is not already of type T. A nil value becomes the zero value of T.
+func convertValue[T any](defaultConverter schema.DefaultConverter, value reflect.Value) (T, error) {
+	const FunctionName = "convertValue"
+
+	var result T
+	targetType := reflect.TypeOf(&result).Elem()
+
+	if value.IsValid() && value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if core.IsNilOrInvalid(value) {
+		return result, nil
+	}
+
+	if value.Type().AssignableTo(targetType) {
+		reflect.ValueOf(&result).Elem().Set(value)
+		return result, nil
+	}
+
+	if defaultConverter == nil {
+		return result, NewError().WithFunctionName(FunctionName).WithMessage("defaultConverter is nil").WithNestedError(ErrObjectError)
+	}
+
+	convertedValue, err := defaultConverter.ConvertNode(value, typeSchema(targetType, make(map[reflect.Type]*schema.DynamicSchemaNode)))
+	if err != nil {
+		return result, err
+	}
+	if !convertedValue.IsValid() || !convertedValue.Type().AssignableTo(targetType) {
+		return result, NewError().WithFunctionName(FunctionName).WithMessage("converted value is not of the target type").WithNestedError(ErrObjectError).WithData(core.JsonObject{"Type": targetType.String()})
+	}
+
+	reflect.ValueOf(&result).Elem().Set(convertedValue)
+	return result, nil
+}
+
+/*
+typeSchema returns a schema that describes valueType and the types it is made up of e.g., the elements of a slice or the fields of a struct.
+
+It allows defaultConverter to convert composite values like a `[]any` decoded from JSON to a `[]int`. schemas holds the schema of each type seen so far so that recursive types are supported.
+*/
+func typeSchema(valueType reflect.Type, schemas map[reflect.Type]*schema.DynamicSchemaNode) *schema.DynamicSchemaNode {
+	if typeSchemaNode, ok := schemas[valueType]; ok {
+		return typeSchemaNode
+	}
+
+	typeSchemaNode := &schema.DynamicSchemaNode{
+		Type: valueType,
+		Kind: valueType.Kind(),
+	}
+	schemas[valueType] = typeSchemaNode
+
+	switch valueType.Kind() {
+	case reflect.Pointer:
+		typeSchemaNode.Nilable = true
+		typeSchemaNode.ChildNodesPointerSchema = typeSchema(valueType.Elem(), schemas)
+	case reflect.Slice, reflect.Array:
+		typeSchemaNode.Nilable = valueType.Kind() == reflect.Slice
+		typeSchemaNode.ChildNodesLinearCollectionElementsSchema = typeSchema(valueType.Elem(), schemas)
+	case reflect.Map:
+		typeSchemaNode.Nilable = true
+		typeSchemaNode.ChildNodesAssociativeCollectionEntriesKeySchema = typeSchema(valueType.Key(), schemas)
+		typeSchemaNode.ChildNodesAssociativeCollectionEntriesValueSchema = typeSchema(valueType.Elem(), schemas)
+	case reflect.Struct:
+		typeSchemaNode.ChildNodes = make(schema.ChildNodes)
+		for i := 0; i < valueType.NumField(); i++ {
+			if field := valueType.Field(i); core.IsStructFieldExported(field) {
+				typeSchemaNode.ChildNodes[field.Name] = typeSchema(field.Type, schemas)
+			}
+		}
+	case reflect.Interface:
+		typeSchemaNode.Nilable = true
+	}
+
+	return typeSchemaNode
+}
@@ -0,0 +1,129 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+)
+
+func getAsTestObject(t *testing.T) *Object {
+	var source any
+	if err := json.Unmarshal([]byte(`{
+		"users": [
+			{"name": "Alice", "age": 30, "active": true, "tags": ["a", "b"], "manager": null},
+			{"name": "Bob", "age": "42", "tags": []}
+		],
+		"store": {"book": [{"price": 8.95}, {"price": 12.99}], "bicycle": {"price": 19.95}}
+	}`), &source); err != nil {
+		t.Fatal(err)
+	}
+	return NewObject().WithSourceInterface(source)
+}
+
+func TestObject_GetAs(t *testing.T) {
+	obj := getAsTestObject(t)
+
+	if age, err := GetAs[int](obj, "$.users[0].age"); err != nil || age != 30 {
+		t.Error("expected=30 got=", age, "err=", err)
+	}
+	if age, err := GetAs[int](obj, "$.users[1].age"); err != nil || age != 42 {
+		t.Error("expected=42 got=", age, "err=", err)
+	}
+	if active, err := GetAs[bool](obj, "$.users[0].active"); err != nil || !active {
+		t.Error("expected=true got=", active, "err=", err)
+	}
+	if name, err := GetAs[string](obj, "$.users[0].name"); err != nil || name != "Alice" {
+		t.Error("expected=Alice got=", name, "err=", err)
+	}
+	if tags, err := GetAs[[]string](obj, "$.users[0].tags"); err != nil || !reflect.DeepEqual(tags, []string{"a", "b"}) {
+		t.Error("expected=[a b] got=", tags, "err=", err)
+	}
+	if names, err := GetAs[[]string](obj, "$.users[*].name"); err != nil || !reflect.DeepEqual(names, []string{"Alice", "Bob"}) {
+		t.Error("expected=[Alice Bob] got=", names, "err=", err)
+	}
+	if ages, err := GetAs[[]int](obj, "$.users[*].age"); err != nil || !reflect.DeepEqual(ages, []int{30, 42}) {
+		t.Error("expected=[30 42] got=", ages, "err=", err)
+	}
+	if bicycle, err := GetAs[map[string]float64](obj, "$.store.bicycle"); err != nil || !reflect.DeepEqual(bicycle, map[string]float64{"price": 19.95}) {
+		t.Error("expected=map[price:19.95] got=", bicycle, "err=", err)
+	}
+	if manager, err := GetAs[*string](obj, "$.users[0].manager"); err != nil || manager != nil {
+		t.Error("expected=nil got=", manager, "err=", err)
+	}
+
+	if _, err := GetAs[int](obj, "$.users[5].age"); err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected ErrValueAtPathSegmentInvalidError when no value found, got err=", err)
+	}
+	if _, err := GetAs[int](obj, "$.users[0].name"); err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected ErrValueAtPathSegmentInvalidError when value cannot be converted, got err=", err)
+	} else if data := err.(*core.Error).Data; data["Path"] != "$.users[0].name" {
+		t.Error("expected error data to have the path, got=", data)
+	}
+
+	profile := &UserProfile{Name: "Alice", Age: 30, Address: Address{City: "Anytown"}}
+	typedObj := NewObject().WithSourceInterface(profile)
+	if address, err := GetAs[Address](typedObj, "$.Address"); err != nil || address.City != "Anytown" {
+		t.Error("got=", address, "err=", err)
+	}
+	if age, err := GetAs[float64](typedObj, "$.Age"); err != nil || age != 30 {
+		t.Error("expected=30 got=", age, "err=", err)
+	}
+	if root, err := GetAs[*UserProfile](typedObj, ""); err != nil || root != profile {
+		t.Error("expected the source, got=", root, "err=", err)
+	}
+}
+
+func TestObject_GetAsOrDefault(t *testing.T) {
+	obj := getAsTestObject(t)
+
+	if age := GetAsOrDefault(obj, "$.users[0].age", -1); age != 30 {
+		t.Error("expected=30 got=", age)
+	}
+	if age := GetAsOrDefault(obj, "$.users[5].age", -1); age != -1 {
+		t.Error("expected=-1 got=", age)
+	}
+	if age := GetAsOrDefault(obj, "$.users[0].name", -1); age != -1 {
+		t.Error("expected=-1 got=", age)
+	}
+}
+
+func TestObject_MustGetAs(t *testing.T) {
+	obj := getAsTestObject(t)
+
+	if price := MustGetAs[float64](obj, "$.store.bicycle.price"); price != 19.95 {
+		t.Error("expected=19.95 got=", price)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected MustGetAs to panic when no value found")
+		} else if err, ok := r.(error); !ok || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+			t.Error("expected panic with ErrValueAtPathSegmentInvalidError, got=", r)
+		}
+	}()
+	MustGetAs[float64](obj, "$.store.car.price")
+}
+
+func TestObject_GetAllAs(t *testing.T) {
+	obj := getAsTestObject(t)
+
+	if prices, err := GetAllAs[float64](obj, "$..price"); err != nil || !reflect.DeepEqual(prices, []float64{19.95, 8.95, 12.99}) {
+		t.Error("expected=[19.95 8.95 12.99] got=", prices, "err=", err)
+	}
+	if ages, err := GetAllAs[int](obj, "$.users[*].age"); err != nil || !reflect.DeepEqual(ages, []int{30, 42}) {
+		t.Error("expected=[30 42] got=", ages, "err=", err)
+	}
+	if tags, err := GetAllAs[[]string](obj, "$.users[*].tags"); err != nil || !reflect.DeepEqual(tags, [][]string{{"a", "b"}, {}}) {
+		t.Error("expected=[[a b] []] got=", tags, "err=", err)
+	}
+
+	if _, err := GetAllAs[int](obj, "$..missing"); err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected ErrValueAtPathSegmentInvalidError when no value found, got err=", err)
+	}
+	if _, err := GetAllAs[int](obj, "$.users[*].name"); err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected ErrValueAtPathSegmentInvalidError when value cannot be converted, got err=", err)
+	}
+}
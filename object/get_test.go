@@ -14,7 +14,7 @@ import (
 func TestObject_Get(t *testing.T) {
 	for testData := range GetTestData {
 		obj := NewObject().WithSourceInterface(testData.Root)
-		noOfResults, err := obj.Get(testData.Path)
+		valueFound, noOfResults, err := obj.Get(testData.Path)
 		if noOfResults != testData.ExpectedOk {
 			t.Error(
 				testData.TestTitle, "\n",
@@ -35,7 +35,6 @@ func TestObject_Get(t *testing.T) {
 			}
 		}
 
-		valueFound := obj.GetValueFoundInterface()
 		if !reflect.DeepEqual(valueFound, testData.ExpectedValue) {
 			t.Error(
 				testData.TestTitle, "\n",
@@ -48,6 +47,47 @@ func TestObject_Get(t *testing.T) {
 	}
 }
 
+func TestObject_InvalidJSONPointer(t *testing.T) {
+	source := map[string]any{"a~2": 1}
+	obj := NewObject().WithSourceInterface(source)
+	isJSONPointerError := func(err error) bool {
+		return errors.Is(err, ErrPathSegmentInvalidError) && errors.Is(err, path.ErrJSONPointerError)
+	}
+
+	if _, noOfResults, err := obj.Get("/a~2"); noOfResults != 0 || !isJSONPointerError(err) {
+		t.Error("Get: expected json pointer error, got err=", err)
+	}
+	if _, err := obj.GetAll("/a~2"); !isJSONPointerError(err) {
+		t.Error("GetAll: expected json pointer error, got err=", err)
+	}
+	if noOfModifications, err := obj.Set("/a~2", 2); noOfModifications != 0 || !isJSONPointerError(err) {
+		t.Error("Set: expected json pointer error, got err=", err)
+	}
+	if noOfModifications, err := obj.Delete("/a~2"); noOfModifications != 0 || !isJSONPointerError(err) {
+		t.Error("Delete: expected json pointer error, got err=", err)
+	}
+	if _, err := obj.Insert("/a/~", 2); !isJSONPointerError(err) {
+		t.Error("Insert: expected json pointer error, got err=", err)
+	}
+	if _, _, err := obj.Update("/a~2", func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+		return value, true, nil
+	}); !isJSONPointerError(err) {
+		t.Error("Update: expected json pointer error, got err=", err)
+	}
+
+	iterator := obj.Iterate("/a~2")
+	for range iterator.All() {
+		t.Error("Iterate: expected no values")
+	}
+	if !isJSONPointerError(iterator.Err()) {
+		t.Error("Iterate: expected json pointer error, got err=", iterator.Err())
+	}
+
+	if !reflect.DeepEqual(source, map[string]any{"a~2": 1}) {
+		t.Error("expected source to be unchanged, got=", source)
+	}
+}
+
 type GetData struct {
 	internal.TestData
 	Root          any
@@ -539,4 +579,231 @@ func GetTestData(yield func(data *GetData) bool) {
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector with logical and comparison operators", testCaseIndex),
+			},
+			Root: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 1, "status": "open", "total": 150},
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+					map[string]any{"id": 4, "status": "open", "total": 120.5},
+				},
+			},
+			Path:          "$.orders[?(@.status == 'open' && @.total > 100)].id",
+			ExpectedOk:    2,
+			ExpectedValue: []any{1, 4},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector with negated existence test on structs", testCaseIndex),
+			},
+			Root: []*User{
+				{Name: "Alice", Email: "alice@example.com"},
+				{Name: "Bob"},
+			},
+			Path:          "$[?@.Email != '' || !@.Name].Name",
+			ExpectedOk:    1,
+			ExpectedValue: []any{"Alice"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector on map members comparing with an absolute query", testCaseIndex),
+			},
+			Root: map[string]any{
+				"limit": 10,
+				"items": map[string]any{
+					"a": map[string]any{"price": 5},
+					"b": map[string]any{"price": 15},
+					"c": map[string]any{"price": 8},
+				},
+			},
+			Path:          "$.items[?@.price <= $.limit]",
+			ExpectedOk:    2,
+			ExpectedValue: []any{map[string]any{"price": 5}, map[string]any{"price": 8}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector in recursive descent", testCaseIndex),
+			},
+			Root: map[string]any{
+				"store": map[string]any{
+					"book": []any{
+						map[string]any{"title": "A", "price": 8.95},
+						map[string]any{"title": "B", "price": 12.99},
+					},
+					"bicycle": map[string]any{"title": "C", "price": 19.95},
+				},
+			},
+			Path:          "$..[?@.price < 10].title",
+			ExpectedOk:    1,
+			ExpectedValue: []any{"A"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Filter selector with no matches", testCaseIndex),
+			},
+			Root: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 1, "status": "open", "total": 150},
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+					map[string]any{"id": 4, "status": "open", "total": 120.5},
+				},
+			},
+			Path:          "$.orders[?@.total > 1000]",
+			ExpectedOk:    0,
+			ExpectedValue: nil,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative index selects the last element", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a", "b", "c"}},
+			Path:          "$.items[-1]",
+			ExpectedOk:    1,
+			ExpectedValue: "c",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative start slice on a fixed-size array", testCaseIndex),
+			},
+			Root:          [5]int{1, 2, 3, 4, 5},
+			Path:          "$[-3:]",
+			ExpectedOk:    3,
+			ExpectedValue: []any{3, 4, 5},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative step slice reverses the elements", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a", "b", "c"}},
+			Path:          "$.items[::-1]",
+			ExpectedOk:    3,
+			ExpectedValue: []any{"c", "b", "a"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Union with negative index", testCaseIndex),
+			},
+			Root:          []User{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}},
+			Path:          "$[0,-1].Name",
+			ExpectedOk:    2,
+			ExpectedValue: []any{"Alice", "Carol"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative index out of range", testCaseIndex),
+			},
+			Root:          []int{1, 2},
+			Path:          "$[-3]",
+			ExpectedOk:    0,
+			ExpectedValue: nil,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: JSON Pointer", testCaseIndex),
+			},
+			Root:          map[string]any{"data": map[string]any{"items": []any{map[string]any{"name": "first"}}}},
+			Path:          "/data/items/0/name",
+			ExpectedOk:    1,
+			ExpectedValue: "first",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: JSON Pointer with escaped and numeric map keys", testCaseIndex),
+			},
+			Root:          map[string]any{"a/b": map[string]any{"0": map[int]any{1: "x"}}},
+			Path:          "/a~1b/0/1",
+			ExpectedOk:    1,
+			ExpectedValue: "x",
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&GetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Invalid JSON Pointer", testCaseIndex),
+			},
+			Root:          map[string]any{"a": 1},
+			Path:          "/a~2",
+			ExpectedOk:    0,
+			ExpectedValue: nil,
+		},
+	) {
+		return
+	}
 }
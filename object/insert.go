@@ -0,0 +1,98 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+/*
+Insert adds value to the slice in `Object.source` at the index that jsonPath ends with, shifting the element at the index and the ones after it.
+
+Unlike Object.Set, which replaces the element at the index, Insert never overwrites an element e.g., `$.items[0]` inserts at the start of items.
+The path can end with `-` e.g., `$.items[-]` or `/items/-` to append after the last element. A negative index counts from the end hence `$.items[-1]` inserts before the last element.
+
+value is converted to the element type of the slice using `Object.defaultConverter` and `Object.schema`. The slice can be a `[]T`, a `[]any`, or a pointer to a slice.
+Slices are created at the path if they do not exist, like Object.Set. Inserting into arrays returns an error since their length is fixed.
+
+Parameters:
+  - jsonPath - Must end with an index or `-`. Other segments can be wildcards, unions, or filters to insert into several slices.
+  - value - value to insert.
+
+Returns the number of values inserted and the last error encountered.
+*/
+func (n *Object) Insert(jsonPath path.JSONPath, value any) (uint64, error) {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, err
+	}
+	return n.insertValue(recursiveDescentSegments, reflect.ValueOf(value))
+}
+
+// InsertCompiled is like Insert but uses a path that has already been compiled with path.Compile.
+func (n *Object) InsertCompiled(compiledPath *path.CompiledPath, value any) (uint64, error) {
+	return n.insertValue(compiledPath.Segments(), reflect.ValueOf(value))
+}
+
+/*
+Append adds values to the end of the slice in `Object.source` at jsonPath e.g., `obj.Append("$.items", item1, item2)`.
+
+It is the same as calling Object.Insert with `[-]` added to jsonPath for each value. Values are appended in order and Append stops at the first value that cannot be appended.
+
+Returns the number of values appended and the last error encountered.
+*/
+func (n *Object) Append(jsonPath path.JSONPath, values ...any) (uint64, error) {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, err
+	}
+	return n.append(recursiveDescentSegments, values)
+}
+
+// AppendCompiled is like Append but uses a path that has already been compiled with path.Compile.
+func (n *Object) AppendCompiled(compiledPath *path.CompiledPath, values ...any) (uint64, error) {
+	return n.append(compiledPath.Segments(), values)
+}
+
+// insertValue is the underlying implementation of Insert that works with an already parsed path.
+func (n *Object) insertValue(recursiveDescentSegments path.RecursiveDescentSegments, value reflect.Value) (uint64, error) {
+	const FunctionName = "Insert"
+
+	if len(recursiveDescentSegments) == 0 || len(recursiveDescentSegments[len(recursiveDescentSegments)-1]) == 0 {
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
+	}
+
+	lastRecursiveDescentSegment := recursiveDescentSegments[len(recursiveDescentSegments)-1]
+	if lastSegment := lastRecursiveDescentSegment[len(lastRecursiveDescentSegment)-1]; lastSegment == nil || (!lastSegment.IsIndex && !lastSegment.IsIndexEnd) {
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("path %s does not end with an index or '-'", recursiveDescentSegments)).WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
+	}
+
+	return n.setOrInsert(recursiveDescentSegments, value, true)
+}
+
+// append is the underlying implementation of Append that works with an already parsed path.
+func (n *Object) append(recursiveDescentSegments path.RecursiveDescentSegments, values []any) (uint64, error) {
+	const FunctionName = "Append"
+
+	if len(recursiveDescentSegments) == 0 {
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
+	}
+
+	// clone the segments so that the segments of a path.CompiledPath are not modified.
+	recursiveDescentSegments = slices.Clone(recursiveDescentSegments)
+	lastRecursiveDescentSegment := len(recursiveDescentSegments) - 1
+	recursiveDescentSegments[lastRecursiveDescentSegment] = append(slices.Clone(recursiveDescentSegments[lastRecursiveDescentSegment]), &path.CollectionMemberSegment{Key: path.JsonpathIndexEnd, IsIndexEnd: true, ExpectLinear: true})
+
+	var noOfResults uint64
+	for _, value := range values {
+		noOfValueResults, err := n.setOrInsert(recursiveDescentSegments, reflect.ValueOf(value), true)
+		noOfResults += noOfValueResults
+		if noOfValueResults == 0 {
+			return noOfResults, err
+		}
+	}
+	return noOfResults, nil
+}
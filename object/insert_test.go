@@ -0,0 +1,350 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+type inventory struct {
+	Tags       []string
+	Quantities *[]int
+	Slots      [2]int
+	Profiles   []*UserProfile
+}
+
+func TestObject_Insert(t *testing.T) {
+	for testData := range InsertTestData {
+		obj := NewObject().WithSourceInterface(testData.Root).WithSchema(testData.Schema)
+
+		ok, err := obj.Insert(testData.Path, testData.ValueToInsert)
+		if ok != testData.ExpectedOk {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected ok=", testData.ExpectedOk, "got=", ok, "\n",
+				"path=", testData.Path, "\n",
+				"err=", err,
+			)
+		}
+
+		if testData.ExpectedErr != nil && !errors.Is(err, testData.ExpectedErr) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected err=", testData.ExpectedErr, "got=", err,
+			)
+		}
+
+		if !reflect.DeepEqual(obj.GetSourceInterface(), testData.ExpectedValue) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"source not equal to testData.ExpectedValue\n",
+				"source=", core.JsonStringifyMust(obj.GetSourceInterface()), "\n",
+				"testData.ExpectedValue=", core.JsonStringifyMust(testData.ExpectedValue),
+			)
+		}
+	}
+}
+
+type InsertData struct {
+	internal.TestData
+	Root          any
+	Schema        schema.Schema
+	Path          path.JSONPath
+	ValueToInsert any
+	ExpectedOk    uint64
+	ExpectedErr   error
+	ExpectedValue any
+}
+
+func InsertTestData(yield func(data *InsertData) bool) {
+	testCaseIndex := 1
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Insert shifts the elements after the index", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a", "c"}},
+			Path:          "$.items[1]",
+			ValueToInsert: "b",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"items": []any{"a", "b", "c"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Append with end of array token", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a"}},
+			Path:          "$.items[-]",
+			ValueToInsert: "b",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"items": []any{"a", "b"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Append with JSON Pointer end of array token", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a"}},
+			Path:          "/items/-",
+			ValueToInsert: "b",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"items": []any{"a", "b"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Negative index inserts before the element it counts to", testCaseIndex),
+			},
+			Root:          []any{"a", "c"},
+			Path:          "$[-1]",
+			ValueToInsert: "b",
+			ExpectedOk:    1,
+			ExpectedValue: []any{"a", "b", "c"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Value converted to element type of pointer to slice", testCaseIndex),
+			},
+			Root:          &inventory{Quantities: &[]int{1}},
+			Path:          "$.Quantities[0]",
+			ValueToInsert: "7",
+			ExpectedOk:    1,
+			ExpectedValue: &inventory{Quantities: &[]int{7, 1}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Schema drives element conversion", testCaseIndex),
+			},
+			Root: nil,
+			Schema: &schema.DynamicSchemaNode{
+				Kind: reflect.Slice,
+				Type: reflect.TypeOf([]*UserProfile{}),
+				ChildNodesLinearCollectionElementsSchema: &schema.DynamicSchemaNode{
+					Kind:                    reflect.Pointer,
+					Type:                    reflect.TypeOf(&UserProfile{}),
+					ChildNodesPointerSchema: UserProfileSchema(),
+				},
+			},
+			Path:          "$[-]",
+			ValueToInsert: map[string]any{"Name": "Alice", "Age": "30"},
+			ExpectedOk:    1,
+			ExpectedValue: []*UserProfile{{Name: "Alice", Age: 30}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Insert into several slices", testCaseIndex),
+			},
+			Root: map[string]any{
+				"orders": []any{
+					map[string]any{"items": []any{"b"}},
+					map[string]any{"items": []any{}},
+				},
+			},
+			Path:          "$.orders[*].items[0]",
+			ValueToInsert: "a",
+			ExpectedOk:    2,
+			ExpectedValue: map[string]any{
+				"orders": []any{
+					map[string]any{"items": []any{"a", "b"}},
+					map[string]any{"items": []any{"a"}},
+				},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Array length is fixed", testCaseIndex),
+			},
+			Root:          &inventory{Slots: [2]int{1, 2}},
+			Path:          "$.Slots[0]",
+			ValueToInsert: 3,
+			ExpectedErr:   ErrValueAtPathSegmentInvalidError,
+			ExpectedValue: &inventory{Slots: [2]int{1, 2}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Index out of range", testCaseIndex),
+			},
+			Root:          []any{"a"},
+			Path:          "$[2]",
+			ValueToInsert: "b",
+			ExpectedErr:   ErrValueAtPathSegmentInvalidError,
+			ExpectedValue: []any{"a"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Path does not end with an index", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []any{"a"}},
+			Path:          "$.items",
+			ValueToInsert: "b",
+			ExpectedErr:   ErrPathSegmentInvalidError,
+			ExpectedValue: map[string]any{"items": []any{"a"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: End of array in nil source", testCaseIndex),
+			},
+			Path:          "$[-]",
+			ValueToInsert: 5,
+			ExpectedOk:    1,
+			ExpectedValue: []any{5},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&InsertData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Insert into missing map entry with schema", testCaseIndex),
+			},
+			Root:          map[string][]int{"x": {1}},
+			Schema:        mapOfIntSlicesSchema(),
+			Path:          "$.y[0]",
+			ValueToInsert: "5",
+			ExpectedOk:    1,
+			ExpectedValue: map[string][]int{"x": {1}, "y": {5}},
+		},
+	) {
+		return
+	}
+}
+
+func mapOfIntSlicesSchema() *schema.DynamicSchemaNode {
+	return &schema.DynamicSchemaNode{
+		Kind: reflect.Map,
+		Type: reflect.TypeOf(map[string][]int{}),
+		ChildNodesAssociativeCollectionEntriesKeySchema: &schema.DynamicSchemaNode{
+			Kind: reflect.String,
+			Type: reflect.TypeOf(""),
+		},
+		ChildNodesAssociativeCollectionEntriesValueSchema: &schema.DynamicSchemaNode{
+			Kind: reflect.Slice,
+			Type: reflect.TypeOf([]int{}),
+			ChildNodesLinearCollectionElementsSchema: &schema.DynamicSchemaNode{
+				Kind: reflect.Int,
+				Type: reflect.TypeOf(0),
+			},
+		},
+	}
+}
+
+func TestObject_Append(t *testing.T) {
+	order := &inventory{}
+	obj := NewObject().WithSourceInterface(order)
+
+	if ok, err := obj.Append("$.Tags", "a", "b"); ok != 2 || !reflect.DeepEqual(order.Tags, []string{"a", "b"}) {
+		t.Error("expected=[a b] got=", order.Tags, "err=", err)
+	}
+	if ok, err := obj.Append("$.Quantities", "1", 2.0); ok != 2 || order.Quantities == nil || !reflect.DeepEqual(*order.Quantities, []int{1, 2}) {
+		t.Error("expected=[1 2] got=", order.Quantities, "err=", err)
+	}
+	if ok, err := obj.Append("$.Slots", 1); ok != 0 || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected error appending to array, got ok=", ok, "err=", err)
+	}
+	if ok, err := obj.Append("$.Tags"); ok != 0 || err != nil {
+		t.Error("expected nothing to be appended, got ok=", ok, "err=", err)
+	}
+
+	compiledPath := path.MustCompile("$.Tags")
+	if ok, err := obj.AppendCompiled(compiledPath, "c"); ok != 1 || !reflect.DeepEqual(order.Tags, []string{"a", "b", "c"}) {
+		t.Error("expected=[a b c] got=", order.Tags, "err=", err)
+	}
+	if compiledPath.String() != "$.Tags" {
+		t.Error("expected compiled path to not be modified, got=", compiledPath.String())
+	}
+
+	source := map[string]any{}
+	if ok, err := NewObject().WithSourceInterface(source).Append("$.items", 1); ok != 1 || !reflect.DeepEqual(source["items"], []any{1}) {
+		t.Error("expected slice to be created, got=", source, "err=", err)
+	}
+
+	typedSource := map[string][]int{}
+	if ok, err := NewObject().WithSourceInterface(typedSource).WithSchema(mapOfIntSlicesSchema()).Append("$.y", "5"); ok != 1 || !reflect.DeepEqual(typedSource["y"], []int{5}) {
+		t.Error("expected typed slice to be created, got=", typedSource, "err=", err)
+	}
+}
+
+func TestObject_SetIndexEnd(t *testing.T) {
+	order := &inventory{Profiles: []*UserProfile{{Name: "Alice"}}}
+	obj := NewObject().WithSourceInterface(order)
+
+	if ok, err := obj.Set("$.Profiles[-].Name", "Bob"); ok != 1 || len(order.Profiles) != 2 || order.Profiles[1].Name != "Bob" {
+		t.Error("expected new profile to be appended, got=", core.JsonStringifyMust(order.Profiles), "err=", err)
+	}
+	if ok, err := obj.Set("/Tags/-", "a"); ok != 1 || !reflect.DeepEqual(order.Tags, []string{"a"}) {
+		t.Error("expected=[a] got=", order.Tags, "err=", err)
+	}
+	if _, ok, _ := obj.Get("$.Tags[-]"); ok != 0 {
+		t.Error("expected end of array to not select an element")
+	}
+	obj = NewObject()
+	if ok, err := obj.Set("$[-]", "a"); ok != 1 || !reflect.DeepEqual(obj.GetSourceInterface(), []any{"a"}) {
+		t.Error("expected slice to be created, got=", core.JsonStringifyMust(obj.GetSourceInterface()), "err=", err)
+	}
+}
@@ -0,0 +1,163 @@
+package object
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+/*
+All returns an iterator over every value in `Object.source` at jsonPath along with its normalized path.
+
+Values are found lazily as the loop asks for them, including through the recursive descent pattern e.g., `$..price`, hence breaking out of the loop
+stops the traversal. The path is a copy that can be kept after the loop.
+
+Use Object.Iterate to check for errors after the loop.
+
+Example:
+
+	for currentPath, value := range obj.All("$..price") {
+		fmt.Println(currentPath.NormalizedString(), value.Interface())
+	}
+*/
+func (n *Object) All(jsonPath path.JSONPath) iter.Seq2[path.RecursiveDescentSegment, reflect.Value] {
+	return n.Iterate(jsonPath).All()
+}
+
+// AllCompiled is like All but uses a path that has already been compiled with path.Compile.
+func (n *Object) AllCompiled(compiledPath *path.CompiledPath) iter.Seq2[path.RecursiveDescentSegment, reflect.Value] {
+	return n.IterateCompiled(compiledPath).All()
+}
+
+/*
+Iterate returns an Iterator over every value in `Object.source` at jsonPath.
+
+Example:
+
+	iterator := obj.Iterate("$.orders[?@.total > 100]")
+	for currentPath, value := range iterator.All() {
+		...
+	}
+	if err := iterator.Err(); err != nil {
+		...
+	}
+*/
+func (n *Object) Iterate(jsonPath path.JSONPath) *Iterator[reflect.Value] {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	iterator := newIterator(n, recursiveDescentSegments, func(value reflect.Value) (reflect.Value, error) {
+		return value, nil
+	})
+	iterator.pathErr = err
+	return iterator
+}
+
+// IterateCompiled is like Iterate but uses a path that has already been compiled with path.Compile.
+func (n *Object) IterateCompiled(compiledPath *path.CompiledPath) *Iterator[reflect.Value] {
+	return newIterator(n, compiledPath.Segments(), func(value reflect.Value) (reflect.Value, error) {
+		return value, nil
+	})
+}
+
+/*
+Values returns an iterator over every value in the source of obj at jsonPath as type T along with its normalized path.
+
+Values that are not of type T are converted using `Object.defaultConverter` e.g., a float64 decoded from JSON to an int.
+The loop ends at the first value that cannot be converted. Use IterateValues to get the error after the loop.
+
+Example:
+
+	total := 0
+	for _, price := range object.Values[int](obj, "$..price") {
+		total += price
+	}
+*/
+func Values[T any](obj *Object, jsonPath path.JSONPath) iter.Seq2[path.RecursiveDescentSegment, T] {
+	return IterateValues[T](obj, jsonPath).All()
+}
+
+// IterateValues returns an Iterator over every value in the source of obj at jsonPath as type T. See Values.
+func IterateValues[T any](obj *Object, jsonPath path.JSONPath) *Iterator[T] {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	iterator := newIterator(obj, recursiveDescentSegments, func(value reflect.Value) (T, error) {
+		return convertValue[T](obj.defaultConverter, value)
+	})
+	iterator.pathErr = err
+	return iterator
+}
+
+/*
+Iterator streams the values found at a path.JSONPath in `Object.source`.
+
+Create one with Object.Iterate or IterateValues. Range over Iterator.All and then check Iterator.Err.
+
+Each call to Iterator.All starts a new traversal of `Object.source`.
+*/
+type Iterator[T any] struct {
+	object                   *Object
+	recursiveDescentSegments path.RecursiveDescentSegments
+
+	// Turns each value found into T.
+	convert func(value reflect.Value) (T, error)
+
+	// Set by the last loop over Iterator.All.
+	err error
+
+	// Set if the path could not be parsed. Returned by Iterator.Err without traversing `Object.source`.
+	pathErr error
+}
+
+func newIterator[T any](object *Object, recursiveDescentSegments path.RecursiveDescentSegments, convert func(value reflect.Value) (T, error)) *Iterator[T] {
+	return &Iterator[T]{
+		object:                   object,
+		recursiveDescentSegments: recursiveDescentSegments,
+		convert:                  convert,
+	}
+}
+
+// All returns an iterator over each value found along with its normalized path. Breaking out of the loop stops the traversal.
+func (n *Iterator[T]) All() iter.Seq2[path.RecursiveDescentSegment, T] {
+	return func(yield func(path.RecursiveDescentSegment, T) bool) {
+		const FunctionName = "Iterator.All"
+
+		n.err = n.pathErr
+		if n.pathErr != nil {
+			return
+		}
+
+		t := n.object.newTraversal(n.recursiveDescentSegments)
+		t.ifValueFoundInObject = func(currentPath path.RecursiveDescentSegment, value reflect.Value) bool {
+			t.noOfResults++
+
+			convertedValue, err := n.convert(value)
+			if err != nil {
+				n.err = NewError().WithFunctionName(FunctionName).WithMessage("convert value failed").WithNestedError(errors.Join(ErrValueAtPathSegmentInvalidError, err)).WithData(core.JsonObject{"Path": currentPath.NormalizedString()})
+				return true
+			}
+
+			return !yield(slices.Clone(currentPath), convertedValue)
+		}
+		t.forEach()
+
+		if n.err == nil && t.noOfResults == 0 {
+			if t.lastError != nil {
+				n.err = t.lastError
+			} else {
+				n.err = NewError().WithFunctionName(FunctionName).WithMessage("no value found at path").WithNestedError(ErrValueAtPathSegmentInvalidError).WithData(core.JsonObject{"Path": n.recursiveDescentSegments.String()})
+			}
+		}
+	}
+}
+
+/*
+Err returns the error encountered by the last loop over Iterator.All.
+
+Like Object.GetAll, it returns an error that wraps ErrValueAtPathSegmentInvalidError if no value was found e.g., a filter selector that selected no members.
+It also returns an error if a value could not be converted to T. Returns nil if the loop was stopped with break after a value was found.
+*/
+func (n *Iterator[T]) Err() error {
+	return n.err
+}
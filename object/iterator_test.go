@@ -0,0 +1,180 @@
+package object
+
+import (
+	"errors"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_All(t *testing.T) {
+	for testData := range ForEachValueTestData {
+		res := make([]any, 0)
+		for _, value := range NewObject().WithSourceInterface(testData.Object).All(testData.Path) {
+			res = append(res, value.Interface())
+		}
+
+		if !reflect.DeepEqual(res, testData.Expected) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected res to be equal to testData.Expected\n",
+				"path=", testData.Path, "\n",
+				"res=", core.JsonStringifyMust(res), "\n",
+				"JSON testData.Expected=", core.JsonStringifyMust(testData.Expected),
+			)
+		}
+	}
+
+	for testData := range GetAllTestData {
+		iterator := NewObject().WithSourceInterface(testData.Object).Iterate(testData.Path)
+
+		// paths are kept after the loop.
+		results := make(map[string]any)
+		paths := make([]path.RecursiveDescentSegment, 0)
+		for currentPath, value := range iterator.All() {
+			paths = append(paths, currentPath)
+			results[currentPath.NormalizedString()] = value.Interface()
+		}
+
+		if testData.ExpectedPaths == nil {
+			if err := iterator.Err(); err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+				t.Error(testData.TestTitle, "\n", "expected ErrValueAtPathSegmentInvalidError, got err=", err, "\n", "path=", testData.Path)
+			}
+			continue
+		}
+
+		if err := iterator.Err(); err != nil {
+			t.Error(testData.TestTitle, "\n", "expected no error, got err=", err, "\n", "path=", testData.Path)
+			continue
+		}
+
+		normalizedPaths := make([]string, 0)
+		values := make([]any, 0)
+		for _, currentPath := range paths {
+			normalizedPaths = append(normalizedPaths, currentPath.NormalizedString())
+			values = append(values, results[currentPath.NormalizedString()])
+		}
+
+		if !reflect.DeepEqual(normalizedPaths, testData.ExpectedPaths) || !reflect.DeepEqual(values, testData.ExpectedValues) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"path=", testData.Path, "\n",
+				"paths=", core.JsonStringifyMust(normalizedPaths), "\n",
+				"values=", core.JsonStringifyMust(values), "\n",
+				"JSON testData.ExpectedPaths=", core.JsonStringifyMust(testData.ExpectedPaths),
+			)
+		}
+	}
+}
+
+func TestObject_All_Break(t *testing.T) {
+	source := map[string]any{
+		"a": map[string]any{"price": 1, "b": map[string]any{"price": 2}},
+		"c": []any{map[string]any{"price": 3}, map[string]any{"price": 4}},
+	}
+
+	// the runtime panics if the traversal continues after the loop ends.
+	res := make([]any, 0)
+	iterator := NewObject().WithSourceInterface(source).Iterate("$..price")
+	for _, value := range iterator.All() {
+		res = append(res, value.Interface())
+		if len(res) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(res, []any{2, 1}) {
+		t.Error("expected=[2,1] got=", core.JsonStringifyMust(res))
+	}
+	if err := iterator.Err(); err != nil {
+		t.Error("expected no error after break, got err=", err)
+	}
+
+	// every loop starts a new traversal.
+	res = make([]any, 0)
+	for _, value := range iterator.All() {
+		res = append(res, value.Interface())
+	}
+	if !reflect.DeepEqual(res, []any{2, 1, 3, 4}) {
+		t.Error("expected=[2,1,3,4] got=", core.JsonStringifyMust(res))
+	}
+}
+
+func TestObject_All_Compose(t *testing.T) {
+	source := []any{
+		map[string]any{"name": "Alice", "total": 150},
+		map[string]any{"name": "Bob", "total": 50},
+		map[string]any{"name": "Carol", "total": 300},
+	}
+	obj := NewObject().WithSourceInterface(source)
+
+	totals := maps.Collect(func(yield func(string, int) bool) {
+		for currentPath, total := range Values[int](obj, "$[?@.total > 100].total") {
+			if !yield(currentPath.NormalizedString(), total) {
+				return
+			}
+		}
+	})
+	if !reflect.DeepEqual(totals, map[string]int{"$[0]['total']": 150, "$[2]['total']": 300}) {
+		t.Error("got=", core.JsonStringifyMust(totals))
+	}
+
+	names := slices.Collect(func(yield func(string) bool) {
+		for _, name := range Values[string](obj, "$[*].name") {
+			if !yield(name) {
+				return
+			}
+		}
+	})
+	if !reflect.DeepEqual(names, []string{"Alice", "Bob", "Carol"}) {
+		t.Error("got=", core.JsonStringifyMust(names))
+	}
+}
+
+func TestObject_Values(t *testing.T) {
+	var source any = map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "age": float64(30), "address": Address{City: "Anytown"}},
+			map[string]any{"name": "Bob", "age": "42", "address": Address{City: "Othertown"}},
+		},
+	}
+	obj := NewObject().WithSourceInterface(source)
+
+	ages := make([]int, 0)
+	iterator := IterateValues[int](obj, "$.users[*].age")
+	for _, age := range iterator.All() {
+		ages = append(ages, age)
+	}
+	if err := iterator.Err(); err != nil || !reflect.DeepEqual(ages, []int{30, 42}) {
+		t.Error("expected=[30,42] got=", ages, "err=", err)
+	}
+
+	addresses := make([]Address, 0)
+	for _, address := range Values[Address](obj, "$..address") {
+		addresses = append(addresses, address)
+	}
+	if !reflect.DeepEqual(addresses, []Address{{City: "Anytown"}, {City: "Othertown"}}) {
+		t.Error("got=", core.JsonStringifyMust(addresses))
+	}
+
+	anyValues := make([]any, 0)
+	for _, value := range Values[any](obj, "$.users[*].name") {
+		anyValues = append(anyValues, value)
+	}
+	if !reflect.DeepEqual(anyValues, []any{"Alice", "Bob"}) {
+		t.Error("got=", core.JsonStringifyMust(anyValues))
+	}
+
+	// the loop ends at the first value that cannot be converted.
+	names := make([]int, 0)
+	iterator = IterateValues[int](obj, "$.users[*].name")
+	for _, name := range iterator.All() {
+		names = append(names, name)
+	}
+	if err := iterator.Err(); err == nil || !errors.Is(err, ErrValueAtPathSegmentInvalidError) || len(names) != 0 {
+		t.Error("expected ErrValueAtPathSegmentInvalidError, got err=", err, "values=", names)
+	}
+}
@@ -0,0 +1,201 @@
+package object
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+/*
+MergePatch applies the RFC 7386 JSON Merge Patch patch to a deep copy of target and returns the result.
+
+target is never modified. See Object.MergePatch.
+
+Example:
+
+	var patch any
+	err := json.Unmarshal([]byte(`{"Status": "inactive", "Address": {"ZipCode": null}}`), &patch)
+
+	patched, err := object.MergePatch(&user, patch)
+*/
+func MergePatch(target any, patch any) (any, error) {
+	obj := NewObject().WithSourceInterface(target)
+	if err := obj.MergePatch(patch); err != nil {
+		return nil, err
+	}
+	return obj.GetSourceInterface(), nil
+}
+
+/*
+MergePatch applies the RFC 7386 JSON Merge Patch patch to `Object.source`.
+
+If patch is a map, its members are merged recursively into `Object.source`: a nil member removes the member from the target while other members
+replace it or, if they are maps, are merged into it. If patch is not a map, it replaces `Object.source`.
+
+The target can be a struct, a pointer to a struct, a typed map, or `map[string]any`. Members are set through Object.Set hence values are converted
+to the type at the path using `Object.schema` and `Object.defaultConverter` e.g., a float64 decoded from JSON becomes an int in a struct field.
+Removing a struct field resets it to its zero value. A missing member that is merged into is first created as an empty object.
+
+Like ApplyPatch, the patch is applied to a deep copy of `Object.source` which only replaces `Object.source` if the whole patch is applied.
+*/
+func (n *Object) MergePatch(patch any) error {
+	workingObject := n.workingCopy()
+
+	if err := workingObject.mergePatch(path.RecursiveDescentSegment{{Key: path.JsonpathKeyRoot, IsKeyRoot: true}}, reflect.ValueOf(patch)); err != nil {
+		return err
+	}
+
+	n.replaceSource(workingObject.source)
+	return nil
+}
+
+// mergePatch merges patch into the value at currentPath.
+func (n *Object) mergePatch(currentPath path.RecursiveDescentSegment, patch reflect.Value) error {
+	patch = indirectValue(patch)
+	if !patch.IsValid() || patch.Kind() != reflect.Map {
+		return n.mergePatchSet(currentPath, patch)
+	}
+
+	target, noOfResults, _ := n.get(path.RecursiveDescentSegments{currentPath})
+	if indirectTarget := indirectValue(target); !indirectTarget.IsValid() || (indirectTarget.Kind() != reflect.Map && indirectTarget.Kind() != reflect.Struct) || (indirectTarget.Kind() == reflect.Map && indirectTarget.IsNil()) {
+		var targetType reflect.Type
+		if noOfResults > 0 && target.IsValid() {
+			targetType = target.Type()
+		} else if len(currentPath) == 1 {
+			targetType = n.sourceType
+		} else if parent, _, _ := n.get(path.RecursiveDescentSegments{currentPath[:len(currentPath)-1]}); indirectValue(parent).Kind() == reflect.Map {
+			// new entry in a typed map e.g., map[string]*Address.
+			targetType = indirectValue(parent).Type().Elem()
+		}
+		if (targetType == nil || targetType.Kind() == reflect.Interface) && n.schema != nil {
+			if targetSchema, err := schema.GetSchemaAtPathWithFieldNameStrategy(currentPath, n.schema, n.fieldNameStrategy); err == nil && targetSchema.Type != nil {
+				targetType = targetSchema.Type
+			}
+		}
+
+		if err := n.mergePatchSet(currentPath, emptyObject(targetType)); err != nil {
+			return err
+		}
+	}
+
+	for _, member := range getCollectionMembers(patch, n.fieldNameStrategy) {
+		memberPath := append(slices.Clone(currentPath), member.segment)
+
+		if core.IsNilOrInvalid(member.value) {
+			if _, noOfResults, _ := n.get(path.RecursiveDescentSegments{memberPath}); noOfResults > 0 {
+				if _, err := n.delete(path.RecursiveDescentSegments{memberPath}); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := n.mergePatch(memberPath, member.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergePatchSet replaces the value at currentPath with value.
+func (n *Object) mergePatchSet(currentPath path.RecursiveDescentSegment, value reflect.Value) error {
+	const FunctionName = "MergePatch"
+
+	if len(currentPath) == 1 {
+		n.source = value
+		return nil
+	}
+
+	if noOfResults, err := n.set(path.RecursiveDescentSegments{currentPath}, value); noOfResults == 0 {
+		return NewError().WithFunctionName(FunctionName).WithMessage("value not set").WithNestedError(errors.Join(ErrValueAtPathSegmentInvalidError, err)).WithData(core.JsonObject{"Path": currentPath.NormalizedString()})
+	}
+	return nil
+}
+
+// emptyObject returns an empty map or struct of valueType. If valueType is not a map, struct, or a pointer to one, it returns an empty `map[string]any`.
+func emptyObject(valueType reflect.Type) reflect.Value {
+	if valueType == nil {
+		return reflect.ValueOf(map[string]any{})
+	}
+
+	switch valueType.Kind() {
+	case reflect.Map:
+		return reflect.MakeMap(valueType)
+	case reflect.Struct:
+		return reflect.New(valueType).Elem()
+	case reflect.Pointer:
+		if elemType := valueType.Elem(); elemType.Kind() == reflect.Map || elemType.Kind() == reflect.Struct {
+			value := reflect.New(elemType)
+			value.Elem().Set(emptyObject(elemType))
+			return value
+		}
+	}
+	return reflect.ValueOf(map[string]any{})
+}
+
+/*
+CreateMergePatch returns the RFC 7386 JSON Merge Patch that turns original into modified when applied with MergePatch.
+
+If both are maps or structs, the patch is a `map[string]any` with the members that were added or changed and a nil member for each member that was removed.
+Members that are maps or structs in both are compared recursively. Other values are compared with AreEqual and included as they are in modified if they differ.
+If either is not a map or struct, the patch is modified itself.
+
+Struct members use the field name. As with any merge patch, a member whose new value is nil is indistinguishable from a removed member.
+*/
+func CreateMergePatch(original any, modified any) any {
+	patch, _ := createMergePatch(NewAreEqual(), reflect.ValueOf(original), reflect.ValueOf(modified))
+	return patch
+}
+
+// createMergePatch returns the merge patch from original to modified and true if they differ.
+func createMergePatch(areEqual *AreEqual, original reflect.Value, modified reflect.Value) (any, bool) {
+	indirectOriginal := indirectValue(original)
+	indirectModified := indirectValue(modified)
+	if !isMergePatchObject(indirectOriginal) || !isMergePatchObject(indirectModified) {
+		if areEqual.AreEqualReflect(indirectOriginal, indirectModified) {
+			return nil, false
+		}
+		return mergePatchValue(modified), true
+	}
+
+	originalMembers := make(map[string]reflect.Value)
+	for _, member := range getCollectionMembers(indirectOriginal, nil) {
+		originalMembers[member.segment.Key] = member.value
+	}
+
+	patch := make(map[string]any)
+	for _, member := range getCollectionMembers(indirectModified, nil) {
+		originalValue, ok := originalMembers[member.segment.Key]
+		delete(originalMembers, member.segment.Key)
+		if !ok {
+			patch[member.segment.Key] = mergePatchValue(member.value)
+			continue
+		}
+		if memberPatch, changed := createMergePatch(areEqual, originalValue, member.value); changed {
+			patch[member.segment.Key] = memberPatch
+		}
+	}
+	for key := range originalMembers {
+		patch[key] = nil
+	}
+
+	return patch, len(patch) > 0
+}
+
+// mergePatchValue returns value in Go form. Nil pointers, maps, slices, and interfaces become nil.
+func mergePatchValue(value reflect.Value) any {
+	if core.IsNilOrInvalid(value) {
+		return nil
+	}
+	return value.Interface()
+}
+
+// isMergePatchObject returns true if value is a non-nil map or a struct.
+func isMergePatchObject(value reflect.Value) bool {
+	return value.IsValid() && ((value.Kind() == reflect.Map && !value.IsNil()) || value.Kind() == reflect.Struct)
+}
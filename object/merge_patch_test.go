@@ -0,0 +1,148 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+)
+
+func TestObject_MergePatch(t *testing.T) {
+	for testData := range MergePatchTestData {
+		var target, patch, expected any
+		for _, document := range []struct {
+			data        string
+			destination *any
+		}{{testData.Target, &target}, {testData.Patch, &patch}, {testData.Expected, &expected}} {
+			if err := json.Unmarshal([]byte(document.data), document.destination); err != nil {
+				t.Fatal(testData.TestTitle, "\n", "invalid document: ", err)
+			}
+		}
+		original := CloneReflect(reflect.ValueOf(target)).Interface()
+
+		result, err := MergePatch(target, patch)
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected=", testData.Expected, "\n",
+				"got=", core.JsonStringifyMust(result), "err=", err,
+			)
+		}
+		if !reflect.DeepEqual(target, original) {
+			t.Error(testData.TestTitle, "\n", "expected target to be unchanged, got=", core.JsonStringifyMust(target))
+		}
+
+		// a generated merge patch must produce the same result.
+		generatedPatch := CreateMergePatch(target, expected)
+		if result, err := MergePatch(target, generatedPatch); err != nil || !reflect.DeepEqual(result, expected) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"generated patch=", core.JsonStringifyMust(generatedPatch), "\n",
+				"expected=", testData.Expected, "\n",
+				"got=", core.JsonStringifyMust(result), "err=", err,
+			)
+		}
+	}
+}
+
+func TestObject_MergePatch_Typed(t *testing.T) {
+	profile := &UserProfile{Name: "Alice", Age: 30, Address: Address{Street: "1 Main St", City: "Anytown", ZipCode: core.Ptr("1234")}}
+
+	var patch any
+	if err := json.Unmarshal([]byte(`{"Age": 31, "Address": {"City": "Othertown", "ZipCode": null}}`), &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := MergePatch(profile, patch)
+	expected := &UserProfile{Name: "Alice", Age: 31, Address: Address{Street: "1 Main St", City: "Othertown"}}
+	if err != nil || !reflect.DeepEqual(result, expected) {
+		t.Error("got=", core.JsonStringifyMust(result), "err=", err)
+	}
+	if profile.Age != 30 || profile.Address.ZipCode == nil {
+		t.Error("expected target to be unchanged, got=", core.JsonStringifyMust(profile))
+	}
+
+	generatedPatch := CreateMergePatch(profile, expected)
+	if !reflect.DeepEqual(generatedPatch, map[string]any{"Age": 31, "Address": map[string]any{"City": "Othertown", "ZipCode": nil}}) {
+		t.Error("got generated patch=", core.JsonStringifyMust(generatedPatch))
+	}
+	if result, err := MergePatch(*profile, generatedPatch); err != nil || !reflect.DeepEqual(result, *expected) {
+		t.Error("got=", core.JsonStringifyMust(result), "err=", err)
+	}
+
+	addresses := map[string]*Address{"home": {City: "Anytown"}}
+	result, err = MergePatch(addresses, map[string]any{"home": map[string]any{"Street": "1 Main St"}, "work": map[string]any{"City": "Othertown"}})
+	if err != nil || !reflect.DeepEqual(result, map[string]*Address{"home": {Street: "1 Main St", City: "Anytown"}, "work": {City: "Othertown"}}) {
+		t.Error("got=", core.JsonStringifyMust(result), "err=", err)
+	}
+	if addresses["home"].Street != "" || len(addresses) != 1 {
+		t.Error("expected target to be unchanged, got=", core.JsonStringifyMust(addresses))
+	}
+
+	obj := NewObject().WithSchema(UserProfileSchema()).WithSourceInterface(nil)
+	if err := obj.MergePatch(map[string]any{"Name": "Bob", "Age": "42", "Address": map[string]any{"City": "Anytown"}}); err != nil {
+		t.Fatal("expected no error, got err=", err)
+	}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), UserProfile{Name: "Bob", Age: 42, Address: Address{City: "Anytown"}}) {
+		t.Error("got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+
+	if err := obj.MergePatch(map[string]any{"Age": "not a number"}); !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected error when value cannot be converted, got err=", err)
+	}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), UserProfile{Name: "Bob", Age: 42, Address: Address{City: "Anytown"}}) {
+		t.Error("expected source to be unchanged after error, got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+	if err := json.Unmarshal([]byte(`{"Items": [{"City": "Othertown"}]}`), &patch); err != nil {
+		t.Fatal(err)
+	}
+	result, err = MergePatch(&struct{ Items []Address }{}, patch)
+	if err != nil || !reflect.DeepEqual(result, &struct{ Items []Address }{Items: []Address{{City: "Othertown"}}}) {
+		t.Error("expected array of objects to be converted to a slice of structs, got=", core.JsonStringifyMust(result), "err=", err)
+	}
+}
+
+type MergePatchData struct {
+	internal.TestData
+	Target   string
+	Patch    string
+	Expected string
+}
+
+// MergePatchTestData is made up of the examples in Appendix A of RFC 7386.
+func MergePatchTestData(yield func(data *MergePatchData) bool) {
+	for i, example := range [][3]string{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	} {
+		if !yield(
+			&MergePatchData{
+				TestData: internal.TestData{
+					TestTitle: fmt.Sprintf("Test Case %d: %s merged with %s", i+1, example[0], example[1]),
+				},
+				Target:   example[0],
+				Patch:    example[1],
+				Expected: example[2],
+			},
+		) {
+			return
+		}
+	}
+}
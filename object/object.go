@@ -2,7 +2,9 @@ package object
 
 import (
 	"reflect"
+	"slices"
 
+	"github.com/rogonion/go-json/core"
 	"github.com/rogonion/go-json/path"
 	"github.com/rogonion/go-json/schema"
 )
@@ -18,25 +20,6 @@ func (n *Object) WithSchema(value schema.Schema) *Object {
 	return n
 }
 
-/*
-GetValueFoundInterface retrieve value found in Go form after Get.
-
-If Object.valueFound.IsValid returns `false`, it will return nil.
-*/
-func (n *Object) GetValueFoundInterface() any {
-	if n.valueFound.IsValid() {
-		return n.valueFound.Interface()
-	}
-	return nil
-}
-
-/*
-GetValueFoundReflected retrieve value found in reflect form after Get.
-*/
-func (n *Object) GetValueFoundReflected() reflect.Value {
-	return n.valueFound
-}
-
 /*
 GetSourceInterface if you want the current state of source in its interface form.
 */
@@ -54,10 +37,11 @@ func (n *Object) GetSourceReflected() reflect.Value {
 	return n.source
 }
 
-// SetSourceInterface sets the source object to work with from an interface{}.
+// SetSourceInterface sets the source object to work with from an interface{}. Sources kept for Undo are discarded.
 func (n *Object) SetSourceInterface(value any) {
 	n.source = reflect.ValueOf(value)
 	n.sourceType = reflect.TypeOf(value)
+	n.undoStack = nil
 }
 
 // WithSourceInterface is a chainable variant of SetSourceInterface.
@@ -66,10 +50,11 @@ func (n *Object) WithSourceInterface(value any) *Object {
 	return n
 }
 
-// SetSourceReflected sets the source object to work with from a reflect.Value.
+// SetSourceReflected sets the source object to work with from a reflect.Value. Sources kept for Undo are discarded.
 func (n *Object) SetSourceReflected(value reflect.Value) {
 	n.source = value
 	n.sourceType = value.Type()
+	n.undoStack = nil
 }
 
 // WithSourceReflected is a chainable variant of SetSourceReflected.
@@ -88,6 +73,68 @@ func (n *Object) SetDefaultConverter(value schema.DefaultConverter) {
 	n.defaultConverter = value
 }
 
+/*
+WithFieldNameStrategy sets how struct fields are resolved in a path.JSONPath.
+
+Example:
+
+	type User struct {
+		FirstName string `json:"first_name"`
+	}
+
+	obj := NewObject().WithSourceInterface(&User{}).WithFieldNameStrategy(core.FieldNameJSONTag)
+	noOfResults, err := obj.Set("$.first_name", "Alice")
+
+If Object.schema is set, the same strategy should be set in `Object.defaultConverter` so that values are converted the same way.
+*/
+func (n *Object) WithFieldNameStrategy(value *core.FieldNameStrategy) *Object {
+	n.SetFieldNameStrategy(value)
+	return n
+}
+
+func (n *Object) SetFieldNameStrategy(value *core.FieldNameStrategy) {
+	n.fieldNameStrategy = value
+}
+
+/*
+WithUndoDepth sets the maximum number of sources replaced by Object.Batch, Object.Restore, Object.ApplyPatch, and Object.MergePatch that Object.Undo can restore.
+
+Undo is disabled by default as each source kept uses memory. The oldest sources are discarded if value is less than the number already kept.
+*/
+func (n *Object) WithUndoDepth(value int) *Object {
+	n.SetUndoDepth(value)
+	return n
+}
+
+func (n *Object) SetUndoDepth(value int) {
+	n.undoDepth = max(value, 0)
+	if len(n.undoStack) > n.undoDepth {
+		n.undoStack = slices.Delete(n.undoStack, 0, len(n.undoStack)-n.undoDepth)
+	}
+}
+
+/*
+WithCopyOnWrite sets whether Set and Delete leave `Object.source` unmodified.
+
+If value is true, Set, Delete, and the methods built on them e.g., Insert and Update, replace `Object.source` with a new root instead of modifying it.
+Only the maps, slices, arrays, structs, and pointers along the modified paths are copied. Everything else is shared with the previous root hence
+values retrieved earlier with Object.GetSourceInterface remain valid and unchanged.
+
+Example:
+
+	obj := NewObject().WithSourceInterface(state).WithCopyOnWrite(true)
+	noOfModifications, err := obj.Set("$.users[0].name", "Bob")
+	nextState := obj.GetSourceInterface() // state is unchanged
+*/
+func (n *Object) WithCopyOnWrite(value bool) *Object {
+	n.SetCopyOnWrite(value)
+	return n
+}
+
+func (n *Object) SetCopyOnWrite(value bool) {
+	n.copyOnWrite = value
+}
+
 func NewObject() *Object {
 	n := new(Object)
 	n.defaultConverter = schema.NewConversion()
@@ -103,6 +150,9 @@ Usage:
  3. Manipulate source using Object.Get, Object.Set, Object.Delete, or Object.ForEach.
  4. Get modified source using Object.GetSourceInterface.
 
+Every operation keeps its own state and returns its results hence Get, GetAll, and ForEach can be called concurrently on the same Object.
+Set and Delete modify the source, use SyncObject if the Object is shared by goroutines that modify it.
+
 Example:
 
 	type Address struct {
@@ -126,7 +176,7 @@ Example:
 		},
 	}
 
-	objManip := NewObject().WithSource(source)
+	objManip := NewObject().WithSourceInterface(source)
 
 	valueFound, ok, err := objManip.Get("$.data.metadata.Address.City")
 
@@ -138,48 +188,48 @@ Example:
 	var modifiedSource any = objManip.GetSourceInterface()
 */
 type Object struct {
-	// Used by ForEach.
-	ifValueFoundInObject IfValueFoundInObject
-
 	// Useful especially with the Set method for creating new nested objects when starting with an empty source.
 	//
 	// Initialize with SetSchema or WithSchema.
 	schema schema.Schema
 
-	// Value to set in source by the Set method.
-	valueToSet reflect.Value
-
-	// Result from Get.
-	valueFound reflect.Value
-
-	// Made by Get, Set and Delete.
-	noOfResults uint64
-
-	// Last error encountered when processing the source especially for the recursive descent pattern or union pattern in path.JSONPath.
-	lastError error
-
 	// Root object to work with.
 	//
-	// Will be modified with Set and Delete.
+	// Will be modified with Set and Delete unless copyOnWrite is true.
 	//
 	// Initialize with NewObject parameter, or SetSourceInterface.
 	source reflect.Value
 	// Computed when you use SetSourceInterface.
 	sourceType reflect.Type
 
-	recursiveDescentSegments path.RecursiveDescentSegments
-
-	// Default converter to use when converting data e.g., valueToSet to the destination type at the path.JSONPath.
+	// Default converter to use when converting data e.g., the value to set to the destination type at the path.JSONPath.
 	//
 	// Initialize with WithDefaultConverter or SetDefaultConverter.
 	defaultConverter schema.DefaultConverter
+
+	// Resolves the names of struct fields in a path.JSONPath e.g., core.FieldNameJSONTag to use the names in the `json` tags.
+	//
+	// Struct fields are resolved by their Go name if not set. Initialize with WithFieldNameStrategy or SetFieldNameStrategy.
+	fieldNameStrategy *core.FieldNameStrategy
+
+	// If true, Set and Delete copy the collections along the modified paths and replace source with the new root instead of modifying source.
+	//
+	// Initialize with WithCopyOnWrite or SetCopyOnWrite.
+	copyOnWrite bool
+
+	// Maximum number of sources replaced by Batch, Restore, ApplyPatch, and MergePatch that are kept for Undo. Undo is disabled if 0.
+	//
+	// Initialize with WithUndoDepth or SetUndoDepth.
+	undoDepth int
+	// Sources that Undo restores with the most recently replaced last.
+	undoStack []reflect.Value
 }
 
 /*
 IfValueFoundInObject is called when value is found at path.JSONPath.
 
 Parameters:
-  - jsonPath - Current jsonPath where value was found.
+  - jsonPath - Concrete path where value was found made up of the root, keys, and indexes. Use path.RecursiveDescentSegment.NormalizedString to get e.g., `$['One'][0]`. Copy it if it is kept after the callback returns.
   - value - value found. If you want the Go (any) value you can call `value.Interface()`
 
 Return `true` to terminate ForEach loop.
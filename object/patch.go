@@ -0,0 +1,308 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+// PatchOp is the operation to perform in a PatchOperation.
+type PatchOp string
+
+const (
+	PatchOpAdd     PatchOp = "add"
+	PatchOpRemove  PatchOp = "remove"
+	PatchOpReplace PatchOp = "replace"
+	PatchOpMove    PatchOp = "move"
+	PatchOpCopy    PatchOp = "copy"
+	PatchOpTest    PatchOp = "test"
+)
+
+/*
+PatchOperation is a single operation in an RFC 6902 JSON Patch document.
+
+Path and From are JSON Pointers e.g., `/items/0`. The last reference token of Path in an add operation can be `-` to append to an array.
+
+Value is used by add, replace, and test. A nil Value is the JSON null.
+*/
+type PatchOperation struct {
+	Op    PatchOp          `json:"op"`
+	Path  path.JSONPointer `json:"path"`
+	From  path.JSONPointer `json:"from,omitempty"`
+	Value any              `json:"value,omitempty"`
+}
+
+// MarshalJSON always includes value for add, replace, and test since null is a valid value.
+func (n PatchOperation) MarshalJSON() ([]byte, error) {
+	operation := map[string]any{"op": n.Op, "path": n.Path}
+	switch n.Op {
+	case PatchOpAdd, PatchOpReplace, PatchOpTest:
+		operation["value"] = n.Value
+	case PatchOpMove, PatchOpCopy:
+		operation["from"] = n.From
+	}
+	return json.Marshal(operation)
+}
+
+// UnmarshalJSON returns an error if a member required by the operation, such as value for add, is missing. Unrecognized members are ignored.
+func (n *PatchOperation) UnmarshalJSON(data []byte) error {
+	const FunctionName = "UnmarshalJSON"
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+
+	type patchOperation PatchOperation
+	var operation patchOperation
+	if err := json.Unmarshal(data, &operation); err != nil {
+		return err
+	}
+
+	required := []string{"op", "path"}
+	switch operation.Op {
+	case PatchOpAdd, PatchOpReplace, PatchOpTest:
+		required = append(required, "value")
+	case PatchOpMove, PatchOpCopy:
+		required = append(required, "from")
+	}
+	for _, member := range required {
+		if _, ok := members[member]; !ok {
+			return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("member '%s' missing", member)).WithNestedError(ErrPatchOperationError).WithData(core.JsonObject{"Operation": string(data)})
+		}
+	}
+
+	*n = PatchOperation(operation)
+	return nil
+}
+
+/*
+Patch is an RFC 6902 JSON Patch document.
+
+It can be decoded from JSON with json.Unmarshal.
+*/
+type Patch []PatchOperation
+
+/*
+ApplyPatch applies patch to a deep copy of source and returns the result.
+
+source is never modified. See Object.ApplyPatch.
+
+Example:
+
+	var patch object.Patch
+	err := json.Unmarshal([]byte(`[{"op":"replace","path":"/status","value":"inactive"}]`), &patch)
+
+	patched, err := object.ApplyPatch(source, patch)
+*/
+func ApplyPatch(source any, patch Patch) (any, error) {
+	obj := NewObject().WithSourceInterface(source)
+	if err := obj.ApplyPatch(patch); err != nil {
+		return nil, err
+	}
+	return obj.GetSourceInterface(), nil
+}
+
+/*
+ApplyPatch applies the operations in patch to `Object.source` in order.
+
+Application is all-or-nothing. The operations are applied to a deep copy of `Object.source` which only replaces `Object.source` if every operation succeeds.
+
+Values are added through Object.Set hence they are converted to the type at the path using `Object.schema` and `Object.defaultConverter`,
+making it possible to patch structs as well as `map[string]any`.
+
+Struct fields cannot be added or removed; add and remove set them and reset them to their zero value respectively.
+Elements cannot be added to or removed from arrays since their length is fixed.
+
+Returns an error with ErrPatchOperationError, or ErrPatchTestFailedError if a test operation failed. The error's core.Error.Data contains the
+`Index` and `Op` of the operation that failed.
+*/
+func (n *Object) ApplyPatch(patch Patch) error {
+	const FunctionName = "ApplyPatch"
+
+	workingObject := n.workingCopy()
+
+	for i, operation := range patch {
+		if err := workingObject.applyPatchOperation(operation); err != nil {
+			return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("operation %d (%s %s) failed", i, operation.Op, operation.Path)).WithNestedError(err).WithData(core.JsonObject{"Index": i, "Op": operation.Op, "Path": operation.Path})
+		}
+	}
+
+	n.replaceSource(workingObject.source)
+	return nil
+}
+
+// applyPatchOperation applies a single operation to `Object.source`.
+func (n *Object) applyPatchOperation(operation PatchOperation) error {
+	const FunctionName = "applyPatchOperation"
+
+	targetPath, err := operation.Path.Parse()
+	if err != nil {
+		return err
+	}
+
+	switch operation.Op {
+	case PatchOpAdd:
+		return n.patchAdd(targetPath, reflect.ValueOf(operation.Value))
+	case PatchOpRemove:
+		return n.patchRemove(targetPath)
+	case PatchOpReplace:
+		if _, err := n.patchGet(targetPath); err != nil {
+			return err
+		}
+		return n.patchSet(targetPath, reflect.ValueOf(operation.Value))
+	case PatchOpMove:
+		fromPath, err := operation.From.Parse()
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(string(operation.Path), string(operation.From)+path.JsonPointerSeparator) {
+			return NewError().WithFunctionName(FunctionName).WithMessage("cannot move a value into one of its children").WithNestedError(ErrPatchOperationError).WithData(core.JsonObject{"From": operation.From, "Path": operation.Path})
+		}
+		if operation.From == operation.Path {
+			_, err := n.patchGet(fromPath)
+			return err
+		}
+
+		value, err := n.patchGet(fromPath)
+		if err != nil {
+			return err
+		}
+		// value may refer to memory that is reset by patchRemove e.g., a struct field.
+		value = CloneReflect(value)
+		if err := n.patchRemove(fromPath); err != nil {
+			return err
+		}
+		return n.patchAdd(targetPath, value)
+	case PatchOpCopy:
+		fromPath, err := operation.From.Parse()
+		if err != nil {
+			return err
+		}
+		value, err := n.patchGet(fromPath)
+		if err != nil {
+			return err
+		}
+		return n.patchAdd(targetPath, CloneReflect(value))
+	case PatchOpTest:
+		value, err := n.patchGet(targetPath)
+		if err != nil {
+			return err
+		}
+		if !n.patchValuesEqual(value, reflect.ValueOf(operation.Value)) {
+			return NewError().WithFunctionName(FunctionName).WithMessage("value at path not equal to test value").WithNestedError(ErrPatchTestFailedError).WithData(core.JsonObject{"Path": operation.Path, "Value": valueFoundInterface(value), "TestValue": operation.Value})
+		}
+		return nil
+	default:
+		return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("unsupported op '%s'", operation.Op)).WithNestedError(ErrPatchOperationError)
+	}
+}
+
+// patchGet returns the value at targetPath or an error if it does not exist.
+func (n *Object) patchGet(targetPath path.RecursiveDescentSegment) (reflect.Value, error) {
+	const FunctionName = "patchGet"
+
+	value, noOfResults, err := n.get(path.RecursiveDescentSegments{targetPath})
+	if noOfResults == 0 {
+		return reflect.Value{}, NewError().WithFunctionName(FunctionName).WithMessage("path not found").WithNestedError(errors.Join(ErrPatchOperationError, err)).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+	}
+	return value, nil
+}
+
+// patchSet sets value at targetPath.
+func (n *Object) patchSet(targetPath path.RecursiveDescentSegment, value reflect.Value) error {
+	const FunctionName = "patchSet"
+
+	if noOfResults, err := n.set(path.RecursiveDescentSegments{targetPath}, value); noOfResults == 0 {
+		return NewError().WithFunctionName(FunctionName).WithMessage("value not set").WithNestedError(errors.Join(ErrPatchOperationError, err)).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+	}
+	return nil
+}
+
+/*
+patchAdd adds value at targetPath.
+
+The parent of targetPath must exist. If the parent is a slice, value is inserted at the index shifting the elements after it or appended if the index is `-`.
+value is converted to the type at targetPath e.g., an object decoded from JSON becomes the struct element of a slice even if `Object.schema` is not set.
+*/
+func (n *Object) patchAdd(targetPath path.RecursiveDescentSegment, value reflect.Value) error {
+	const FunctionName = "patchAdd"
+
+	if len(targetPath) < 2 {
+		return n.patchSet(targetPath, value)
+	}
+
+	parentPath := targetPath[:len(targetPath)-1]
+	parent, err := n.patchGet(parentPath)
+	if err != nil {
+		return err
+	}
+	parent = patchUnwrap(parent)
+	if core.IsNilOrInvalid(parent) {
+		return NewError().WithFunctionName(FunctionName).WithMessage("parent is null").WithNestedError(ErrPatchOperationError).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+	}
+
+	last := targetPath[len(targetPath)-1]
+	switch parent.Kind() {
+	case reflect.Array:
+		return NewError().WithFunctionName(FunctionName).WithMessage("cannot add an element to an array with a fixed length").WithNestedError(ErrPatchOperationError).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+	case reflect.Slice:
+		if !last.IsIndex && !last.IsIndexEnd {
+			return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("'%s' is not an array index", last.Key)).WithNestedError(ErrPatchOperationError).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+		}
+
+		if noOfResults, err := n.insertValue(path.RecursiveDescentSegments{targetPath}, value); noOfResults == 0 {
+			return NewError().WithFunctionName(FunctionName).WithMessage("value not added").WithNestedError(errors.Join(ErrPatchOperationError, err)).WithData(core.JsonObject{"Path": targetPath.NormalizedString(), "Length": parent.Len()})
+		}
+		return nil
+	default:
+		return n.patchSet(targetPath, value)
+	}
+}
+
+// patchRemove removes the value at targetPath which must exist.
+func (n *Object) patchRemove(targetPath path.RecursiveDescentSegment) error {
+	const FunctionName = "patchRemove"
+
+	if _, err := n.patchGet(targetPath); err != nil {
+		return err
+	}
+
+	if len(targetPath) > 1 {
+		if parent, err := n.patchGet(targetPath[:len(targetPath)-1]); err == nil && patchUnwrap(parent).Kind() == reflect.Array {
+			return NewError().WithFunctionName(FunctionName).WithMessage("cannot remove an element from an array with a fixed length").WithNestedError(ErrPatchOperationError).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+		}
+	}
+
+	if noOfResults, err := n.delete(path.RecursiveDescentSegments{targetPath}); noOfResults == 0 {
+		return NewError().WithFunctionName(FunctionName).WithMessage("value not removed").WithNestedError(errors.Join(ErrPatchOperationError, err)).WithData(core.JsonObject{"Path": targetPath.NormalizedString()})
+	}
+	return nil
+}
+
+/*
+patchValuesEqual checks if the value at a path is equal to the value of a test operation.
+
+testValue is never converted. Numbers of different kinds e.g., a float64 decoded from JSON and an int, are compared by value,
+and structs are compared with maps member by member using the names resolved with `Object.fieldNameStrategy`. Strings are never equal to numbers or booleans.
+*/
+func (n *Object) patchValuesEqual(value reflect.Value, testValue reflect.Value) bool {
+	return NewAreEqual().WithOptions(AreEqualOptions{
+		NumericCoercion:   true,
+		StructMapCoercion: true,
+		FieldNameStrategy: n.fieldNameStrategy,
+	}).AreEqualReflect(patchUnwrap(value), patchUnwrap(testValue))
+}
+
+// patchUnwrap returns the value held by an interface or pointed to by a pointer.
+func patchUnwrap(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer) && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
@@ -0,0 +1,466 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+)
+
+func TestObject_ApplyPatch(t *testing.T) {
+	for testData := range ApplyPatchTestData {
+		var root any
+		if err := json.Unmarshal([]byte(testData.Root), &root); err != nil {
+			t.Fatal(testData.TestTitle, "\n", "invalid root: ", err)
+		}
+		original := CloneReflect(reflect.ValueOf(root)).Interface()
+
+		var patch Patch
+		if err := json.Unmarshal([]byte(testData.Patch), &patch); err != nil {
+			t.Fatal(testData.TestTitle, "\n", "invalid patch: ", err)
+		}
+
+		result, err := ApplyPatch(root, patch)
+
+		if !reflect.DeepEqual(root, original) {
+			t.Error(testData.TestTitle, "\n", "expected source to be unchanged, got=", core.JsonStringifyMust(root))
+		}
+
+		if testData.ExpectedErr != nil {
+			var patchError *core.Error
+			if !errors.Is(err, testData.ExpectedErr) || !errors.As(err, &patchError) || patchError.Data["Index"] != testData.ExpectedIndex {
+				t.Error(
+					testData.TestTitle, "\n",
+					"expected err=", testData.ExpectedErr, "at index=", testData.ExpectedIndex, "\n",
+					"got err=", err,
+				)
+			}
+			continue
+		}
+
+		var expected any
+		if err := json.Unmarshal([]byte(testData.Expected), &expected); err != nil {
+			t.Fatal(testData.TestTitle, "\n", "invalid expected: ", err)
+		}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected=", testData.Expected, "\n",
+				"got=", core.JsonStringifyMust(result), "err=", err,
+			)
+		}
+	}
+}
+
+func TestObject_ApplyPatch_Typed(t *testing.T) {
+	user := &User{ID: 1, Name: "Alice", Email: "alice@example.com"}
+
+	var patch Patch
+	if err := json.Unmarshal([]byte(`[
+		{"op": "test", "path": "/ID", "value": 1},
+		{"op": "replace", "path": "/Name", "value": "Bob"},
+		{"op": "move", "from": "/Email", "path": "/Name"}
+	]`), &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	obj := NewObject().WithSourceInterface(user)
+	if err := obj.ApplyPatch(patch); err != nil {
+		t.Fatal("expected no error, got err=", err)
+	}
+	if patched := obj.GetSourceInterface().(*User); *patched != (User{ID: 1, Name: "alice@example.com"}) {
+		t.Error("got=", core.JsonStringifyMust(patched))
+	}
+	if *user != (User{ID: 1, Name: "Alice", Email: "alice@example.com"}) {
+		t.Error("expected source to be unchanged, got=", core.JsonStringifyMust(user))
+	}
+
+	scores := map[string][]int{"alice": {1, 2}}
+	result, err := ApplyPatch(scores, Patch{
+		{Op: PatchOpAdd, Path: "/alice/-", Value: 3.0},
+		{Op: PatchOpAdd, Path: "/alice/0", Value: "0"},
+		{Op: PatchOpCopy, From: "/alice", Path: "/bob"},
+	})
+	if err != nil || !reflect.DeepEqual(result, map[string][]int{"alice": {0, 1, 2, 3}, "bob": {0, 1, 2, 3}}) {
+		t.Error("got=", core.JsonStringifyMust(result), "err=", err)
+	}
+	if !reflect.DeepEqual(scores, map[string][]int{"alice": {1, 2}}) {
+		t.Error("expected source to be unchanged, got=", core.JsonStringifyMust(scores))
+	}
+
+	if _, err := ApplyPatch(&[2]int{1, 2}, Patch{{Op: PatchOpAdd, Path: "/0", Value: 0}}); !errors.Is(err, ErrPatchOperationError) {
+		t.Error("expected error when adding to a fixed length array, got err=", err)
+	}
+
+	if err := json.Unmarshal([]byte(`[
+		{"op": "add", "path": "/Items/-", "value": {"Name": "b", "Value": 2}},
+		{"op": "add", "path": "/Items/0", "value": {"Name": "a", "Value": 1.0}}
+	]`), &patch); err != nil {
+		t.Fatal(err)
+	}
+	result, err = ApplyPatch(&ComplexData{Items: make([]struct {
+		Name  string
+		Value int
+	}, 0)}, patch)
+	if err != nil {
+		t.Fatal("expected objects to be converted to the struct elements, got err=", err)
+	}
+	if items := result.(*ComplexData).Items; len(items) != 2 || items[0].Name != "a" || items[0].Value != 1 || items[1].Name != "b" || items[1].Value != 2 {
+		t.Error("got=", core.JsonStringifyMust(items))
+	}
+}
+
+func TestObject_ApplyPatch_TestCompare(t *testing.T) {
+	var data ComplexData
+	if err := json.Unmarshal([]byte(`{"ID": 1, "Items": [{"Name": "a", "Value": 1}], "User": {"ID": 2, "Name": "Bob"}}`), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	var patch Patch
+	if err := json.Unmarshal([]byte(`[{"op": "test", "path": "/Items/0/Value", "value": 1.5}]`), &patch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ApplyPatch(&data, patch); !errors.Is(err, ErrPatchTestFailedError) {
+		t.Error("expected 1.5 not to equal 1, got err=", err)
+	}
+
+	if err := json.Unmarshal([]byte(`[
+		{"op": "test", "path": "/Items/0/Value", "value": 1},
+		{"op": "test", "path": "/User", "value": {"ID": 2, "Name": "Bob", "Email": ""}},
+		{"op": "test", "path": "/Items", "value": [{"Name": "a", "Value": 1}]}
+	]`), &patch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ApplyPatch(&data, patch); err != nil {
+		t.Error("expected JSON decoded values to equal typed values, got err=", err)
+	}
+
+	if err := json.Unmarshal([]byte(`[{"op": "test", "path": "/a", "value": [1, 2]}]`), &patch); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ApplyPatch(map[string]any{"a": []any{1, 2}}, patch); err != nil {
+		t.Error("expected [1,2] to equal []any{1,2}, got err=", err)
+	}
+	if _, err := ApplyPatch(map[string]any{"a": []any{"1", 2}}, patch); !errors.Is(err, ErrPatchTestFailedError) {
+		t.Error("expected a string not to equal a number, got err=", err)
+	}
+}
+
+func TestObject_PatchOperation_JSON(t *testing.T) {
+	var patch Patch
+	if err := json.Unmarshal([]byte(`[{"op": "add", "path": "/a"}]`), &patch); !errors.Is(err, ErrPatchOperationError) {
+		t.Error("expected error for missing value, got err=", err)
+	}
+	if err := json.Unmarshal([]byte(`[{"op": "move", "path": "/a"}]`), &patch); !errors.Is(err, ErrPatchOperationError) {
+		t.Error("expected error for missing from, got err=", err)
+	}
+
+	data, err := json.Marshal(Patch{{Op: PatchOpAdd, Path: "/a", Value: nil}, {Op: PatchOpRemove, Path: "/b"}})
+	if err != nil || string(data) != `[{"op":"add","path":"/a","value":null},{"op":"remove","path":"/b"}]` {
+		t.Error("got=", string(data), "err=", err)
+	}
+}
+
+type ApplyPatchData struct {
+	internal.TestData
+	Root          string
+	Patch         string
+	Expected      string
+	ExpectedErr   error
+	ExpectedIndex int
+}
+
+// ApplyPatchTestData is made up of the examples in Appendix A of RFC 6902.
+func ApplyPatchTestData(yield func(data *ApplyPatchData) bool) {
+	testCaseIndex := 1
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.1 Adding an Object Member", testCaseIndex),
+			},
+			Root:     `{"foo": "bar"}`,
+			Patch:    `[{"op": "add", "path": "/baz", "value": "qux"}]`,
+			Expected: `{"baz": "qux", "foo": "bar"}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.2 Adding an Array Element", testCaseIndex),
+			},
+			Root:     `{"foo": ["bar", "baz"]}`,
+			Patch:    `[{"op": "add", "path": "/foo/1", "value": "qux"}]`,
+			Expected: `{"foo": ["bar", "qux", "baz"]}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.3 Removing an Object Member", testCaseIndex),
+			},
+			Root:     `{"baz": "qux", "foo": "bar"}`,
+			Patch:    `[{"op": "remove", "path": "/baz"}]`,
+			Expected: `{"foo": "bar"}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.4 Removing an Array Element", testCaseIndex),
+			},
+			Root:     `{"foo": ["bar", "qux", "baz"]}`,
+			Patch:    `[{"op": "remove", "path": "/foo/1"}]`,
+			Expected: `{"foo": ["bar", "baz"]}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.5 Replacing a Value", testCaseIndex),
+			},
+			Root:     `{"baz": "qux", "foo": "bar"}`,
+			Patch:    `[{"op": "replace", "path": "/baz", "value": "boo"}]`,
+			Expected: `{"baz": "boo", "foo": "bar"}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.6 Moving a Value", testCaseIndex),
+			},
+			Root:     `{"foo": {"bar": "baz", "waldo": "fred"}, "qux": {"corge": "grault"}}`,
+			Patch:    `[{"op": "move", "from": "/foo/waldo", "path": "/qux/thud"}]`,
+			Expected: `{"foo": {"bar": "baz"}, "qux": {"corge": "grault", "thud": "fred"}}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.7 Moving an Array Element", testCaseIndex),
+			},
+			Root:     `{"foo": ["all", "grass", "cows", "eat"]}`,
+			Patch:    `[{"op": "move", "from": "/foo/1", "path": "/foo/3"}]`,
+			Expected: `{"foo": ["all", "cows", "eat", "grass"]}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.8 Testing a Value: Success", testCaseIndex),
+			},
+			Root:     `{"baz": "qux", "foo": ["a", 2, "c"]}`,
+			Patch:    `[{"op": "test", "path": "/baz", "value": "qux"}, {"op": "test", "path": "/foo/1", "value": 2}]`,
+			Expected: `{"baz": "qux", "foo": ["a", 2, "c"]}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.9 Testing a Value: Error", testCaseIndex),
+			},
+			Root:          `{"baz": "qux"}`,
+			Patch:         `[{"op": "test", "path": "/baz", "value": "bar"}]`,
+			ExpectedErr:   ErrPatchTestFailedError,
+			ExpectedIndex: 0,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.10 Adding a Nested Member Object", testCaseIndex),
+			},
+			Root:     `{"foo": "bar"}`,
+			Patch:    `[{"op": "add", "path": "/child", "value": {"grandchild": {}}}]`,
+			Expected: `{"foo": "bar", "child": {"grandchild": {}}}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.11 Ignoring Unrecognized Elements", testCaseIndex),
+			},
+			Root:     `{"foo": "bar"}`,
+			Patch:    `[{"op": "add", "path": "/baz", "value": "qux", "xyz": 123}]`,
+			Expected: `{"foo": "bar", "baz": "qux"}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.12 Adding to a Nonexistent Target", testCaseIndex),
+			},
+			Root:          `{"foo": "bar"}`,
+			Patch:         `[{"op": "add", "path": "/baz/bat", "value": "qux"}]`,
+			ExpectedErr:   ErrPatchOperationError,
+			ExpectedIndex: 0,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				// encoding/json keeps the last duplicate member hence this is a remove of a nonexistent member.
+				TestTitle: fmt.Sprintf("Test Case %d: A.13 Invalid JSON Patch Document", testCaseIndex),
+			},
+			Root:          `{"foo": "bar"}`,
+			Patch:         `[{"op": "add", "path": "/baz", "value": "qux", "op": "remove"}]`,
+			ExpectedErr:   ErrPatchOperationError,
+			ExpectedIndex: 0,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.14 ~ Escape Ordering", testCaseIndex),
+			},
+			Root:     `{"/": 9, "~1": 10}`,
+			Patch:    `[{"op": "test", "path": "/~01", "value": 10}]`,
+			Expected: `{"/": 9, "~1": 10}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.15 Comparing Strings and Numbers", testCaseIndex),
+			},
+			Root:          `{"/": 9, "~1": 10}`,
+			Patch:         `[{"op": "test", "path": "/~01", "value": "10"}]`,
+			ExpectedErr:   ErrPatchTestFailedError,
+			ExpectedIndex: 0,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: A.16 Adding an Array Value", testCaseIndex),
+			},
+			Root:     `{"foo": ["bar"]}`,
+			Patch:    `[{"op": "add", "path": "/foo/-", "value": ["abc", "def"]}]`,
+			Expected: `{"foo": ["bar", ["abc", "def"]]}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: All or nothing", testCaseIndex),
+			},
+			Root:          `{"foo": ["bar"], "baz": 1}`,
+			Patch:         `[{"op": "remove", "path": "/baz"}, {"op": "add", "path": "/foo/-", "value": 2}, {"op": "replace", "path": "/qux", "value": 3}]`,
+			ExpectedErr:   ErrPatchOperationError,
+			ExpectedIndex: 2,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Copy and move into own child", testCaseIndex),
+			},
+			Root:          `{"foo": {"bar": [1]}}`,
+			Patch:         `[{"op": "copy", "from": "/foo", "path": "/qux"}, {"op": "add", "path": "/qux/bar/0", "value": 0}, {"op": "move", "from": "/foo", "path": "/foo/bar/0"}]`,
+			ExpectedErr:   ErrPatchOperationError,
+			ExpectedIndex: 2,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Copy does not share values", testCaseIndex),
+			},
+			Root:     `{"foo": {"bar": [1]}}`,
+			Patch:    `[{"op": "copy", "from": "/foo", "path": "/qux"}, {"op": "add", "path": "/qux/bar/0", "value": 0}, {"op": "replace", "path": "", "value": {"foo": {"bar": [1]}, "qux": {"bar": [0, 1]}}}, {"op": "test", "path": "/qux/bar", "value": [0, 1]}]`,
+			Expected: `{"foo": {"bar": [1]}, "qux": {"bar": [0, 1]}}`,
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&ApplyPatchData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Array index out of range", testCaseIndex),
+			},
+			Root:          `{"foo": ["bar"]}`,
+			Patch:         `[{"op": "add", "path": "/foo/2", "value": "baz"}]`,
+			ExpectedErr:   ErrPatchOperationError,
+			ExpectedIndex: 0,
+		},
+	) {
+		return
+	}
+}
@@ -0,0 +1,303 @@
+package object
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+/*
+Pick returns a deep copy of source that only contains the values at jsonPaths. See Object.Pick.
+
+Example:
+
+	masked, err := object.Pick(user, "$.id", "$.name", "$.address.city")
+*/
+func Pick(source any, jsonPaths ...path.JSONPath) (any, error) {
+	return NewObject().WithSourceInterface(source).Pick(jsonPaths...)
+}
+
+/*
+Omit returns a deep copy of source without the values at jsonPaths. See Object.Omit.
+*/
+func Omit(source any, jsonPaths ...path.JSONPath) (any, error) {
+	return NewObject().WithSourceInterface(source).Omit(jsonPaths...)
+}
+
+/*
+Pick returns a deep copy of `Object.source` that only contains the values at jsonPaths. `Object.source` is not modified.
+
+The result has the same type as `Object.source`. Map entries that were not selected are left out, struct fields that were not selected are zeroed,
+and array/slice elements that were not selected are zeroed so that the indexes of the selected elements are kept.
+
+jsonPaths may contain the recursive descent pattern, wildcards, unions, and filters e.g., `$.items[*].id` or `$..price`.
+Paths that do not match a value are ignored.
+
+jsonPaths are parsed with path.JSONPath.ParseStrict so that a malformed mask returns an error with ErrPathSegmentInvalidError instead of selecting the wrong values.
+*/
+func (n *Object) Pick(jsonPaths ...path.JSONPath) (any, error) {
+	projection, err := n.projectionTree(jsonPaths)
+	if err != nil {
+		return nil, err
+	}
+	return valueFoundInterface(n.pick(n.source, projection)), nil
+}
+
+// pick returns a copy of currentValue that only contains the members selected in projection.
+func (n *Object) pick(currentValue reflect.Value, projection *projectionNode) reflect.Value {
+	if projection.selected {
+		return CloneReflect(currentValue)
+	}
+
+	if core.IsNilOrInvalid(currentValue) {
+		return currentValue
+	}
+
+	switch currentValue.Kind() {
+	case reflect.Interface:
+		valueCopy := reflect.New(currentValue.Type()).Elem()
+		if pickedValue := n.pick(currentValue.Elem(), projection); pickedValue.IsValid() {
+			valueCopy.Set(pickedValue)
+		}
+		return valueCopy
+	case reflect.Pointer:
+		valueCopy := reflect.New(currentValue.Type().Elem())
+		valueCopy.Elem().Set(n.pick(currentValue.Elem(), projection))
+		return valueCopy
+	case reflect.Map:
+		valueCopy := reflect.MakeMap(currentValue.Type())
+		iter := currentValue.MapRange()
+		for iter.Next() {
+			if child, ok := projection.children[projectionKey{key: mapKeyString(iter.Key())}]; ok {
+				valueCopy.SetMapIndex(iter.Key(), n.pick(iter.Value(), child))
+			}
+		}
+		return valueCopy
+	case reflect.Slice, reflect.Array:
+		var valueCopy reflect.Value
+		if currentValue.Kind() == reflect.Slice {
+			valueCopy = reflect.MakeSlice(currentValue.Type(), currentValue.Len(), currentValue.Len())
+		} else {
+			valueCopy = reflect.New(currentValue.Type()).Elem()
+		}
+		for i := 0; i < currentValue.Len(); i++ {
+			if child, ok := projection.children[projectionKey{index: i, isIndex: true}]; ok {
+				valueCopy.Index(i).Set(n.pick(currentValue.Index(i), child))
+			}
+		}
+		return valueCopy
+	case reflect.Struct:
+		valueCopy := reflect.New(currentValue.Type()).Elem()
+		for key, child := range projection.children {
+			if structField, ok := n.fieldNameStrategy.Field(currentValue.Type(), key.key); ok && !key.isIndex {
+				if structFieldValue := structField.Value(currentValue); structFieldValue.IsValid() {
+					structField.SettableValue(valueCopy).Set(n.pick(structFieldValue, child))
+				}
+			}
+		}
+		return valueCopy
+	default:
+		return reflect.Zero(currentValue.Type())
+	}
+}
+
+/*
+PickProjection is like Pick but returns the selected values in a map[string]any along with a schema describing it.
+
+Maps and structs leading to the selected values become map[string]any keyed by the map key or struct field name, and arrays/slices become []any.
+The selected values are deep copies that keep their type. Selecting the root `$` selects each of its members.
+
+If `Object.schema` is set, the schema returned describes the projection. The schema of each selected value is the one at its path in `Object.schema`.
+Otherwise, the schema returned is nil.
+
+Returns an error with ErrValueAtPathSegmentInvalidError if `Object.source` is not a map or struct.
+
+Example:
+
+	projection, projectionSchema, err := obj.PickProjection("$.id", "$.address.city")
+	// projection is map[string]any{"id": 1, "address": map[string]any{"city": "Nairobi"}}
+*/
+func (n *Object) PickProjection(jsonPaths ...path.JSONPath) (map[string]any, *schema.DynamicSchemaNode, error) {
+	const FunctionName = "PickProjection"
+
+	source := indirectValue(n.source)
+	if _, _, ok := core.GetMapKeyValueType(source); !ok && (!source.IsValid() || source.Kind() != reflect.Struct) {
+		return nil, nil, NewError().WithFunctionName(FunctionName).WithMessage("source is not a map or struct").WithNestedError(ErrValueAtPathSegmentInvalidError)
+	}
+
+	projection, err := n.projectionTree(jsonPaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	if projection.selected {
+		projection = &projectionNode{children: make(map[projectionKey]*projectionNode)}
+		for _, member := range getCollectionMembers(source, n.fieldNameStrategy) {
+			projection.children[projectionKey{key: member.segment.Key}] = &projectionNode{selected: true}
+		}
+	}
+
+	projectedValue, projectedSchema := n.project(source, projection, path.RecursiveDescentSegment{{Key: path.JsonpathKeyRoot, IsKeyRoot: true}})
+	return projectedValue.(map[string]any), projectedSchema, nil
+}
+
+// project returns the members of currentValue selected in projection as a map[string]any or []any along with the schema describing it.
+func (n *Object) project(currentValue reflect.Value, projection *projectionNode, currentPath path.RecursiveDescentSegment) (any, *schema.DynamicSchemaNode) {
+	if projection.selected {
+		var selectedSchema *schema.DynamicSchemaNode
+		if n.schema != nil {
+			selectedSchema, _ = schema.GetSchemaAtPathWithFieldNameStrategy(currentPath, n.schema, n.fieldNameStrategy)
+		}
+		return valueFoundInterface(CloneReflect(currentValue)), selectedSchema
+	}
+
+	currentValue = indirectValue(currentValue)
+	if !currentValue.IsValid() {
+		return nil, nil
+	}
+
+	var childNodes schema.ChildNodes
+	if n.schema != nil {
+		childNodes = make(schema.ChildNodes)
+	}
+
+	if _, ok := core.GetArraySliceValueType(currentValue); ok {
+		projectedValue := make([]any, currentValue.Len())
+		for i := range projectedValue {
+			if child, ok := projection.children[projectionKey{index: i, isIndex: true}]; ok {
+				var childSchema *schema.DynamicSchemaNode
+				projectedValue[i], childSchema = n.project(currentValue.Index(i), child, append(currentPath[:len(currentPath):len(currentPath)], &path.CollectionMemberSegment{Index: i, IsIndex: true}))
+				if childNodes != nil && childSchema != nil {
+					childNodes[strconv.Itoa(i)] = childSchema
+				}
+			}
+		}
+		if childNodes == nil {
+			return projectedValue, nil
+		}
+		return projectedValue, &schema.DynamicSchemaNode{
+			Kind:                                     reflect.Slice,
+			Type:                                     reflect.TypeOf(projectedValue),
+			ChildNodes:                               childNodes,
+			ChildNodesLinearCollectionElementsSchema: projectionAnySchema(),
+		}
+	}
+
+	projectedValue := make(map[string]any)
+	for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+		if child, ok := projection.children[projectionKey{key: member.segment.Key}]; ok {
+			var childSchema *schema.DynamicSchemaNode
+			projectedValue[member.segment.Key], childSchema = n.project(member.value, child, append(currentPath[:len(currentPath):len(currentPath)], member.segment))
+			if childNodes != nil && childSchema != nil {
+				childNodes[member.segment.Key] = childSchema
+			}
+		}
+	}
+	if childNodes == nil {
+		return projectedValue, nil
+	}
+	return projectedValue, &schema.DynamicSchemaNode{
+		Kind:       reflect.Map,
+		Type:       reflect.TypeOf(projectedValue),
+		ChildNodes: childNodes,
+		ChildNodesAssociativeCollectionEntriesKeySchema: &schema.DynamicSchemaNode{
+			Kind: reflect.String,
+			Type: reflect.TypeOf(""),
+		},
+		ChildNodesAssociativeCollectionEntriesValueSchema: projectionAnySchema(),
+	}
+}
+
+// projectionAnySchema returns the schema for the members of a projection that were not selected.
+func projectionAnySchema() *schema.DynamicSchemaNode {
+	return &schema.DynamicSchemaNode{
+		Kind:    reflect.Interface,
+		Type:    reflect.TypeOf((*any)(nil)).Elem(),
+		Nilable: true,
+	}
+}
+
+/*
+Omit returns a deep copy of `Object.source` without the values at jsonPaths. `Object.source` is not modified.
+
+Values are removed with Object.Delete hence map entries and array/slice elements are removed and struct fields are zeroed.
+The paths of all values to remove are found before any is removed so that the indexes in jsonPaths refer to `Object.source`.
+
+jsonPaths may contain the recursive descent pattern, wildcards, unions, and filters. Paths that do not match a value are ignored.
+Like Object.Pick, jsonPaths are parsed with path.JSONPath.ParseStrict and a malformed path returns an error.
+*/
+func (n *Object) Omit(jsonPaths ...path.JSONPath) (any, error) {
+	workingObject := n.workingCopy()
+
+	pathsToDelete := make([]path.RecursiveDescentSegment, 0)
+	for _, jsonPath := range jsonPaths {
+		recursiveDescentSegments, err := parseStrictPath(jsonPath)
+		if err != nil {
+			return nil, err
+		}
+		results, _ := workingObject.getAll(recursiveDescentSegments)
+		for _, result := range results {
+			pathsToDelete = append(pathsToDelete, result.Path)
+		}
+	}
+	slices.SortFunc(pathsToDelete, compareConcretePaths)
+	pathsToDelete = slices.CompactFunc(pathsToDelete, func(a, b path.RecursiveDescentSegment) bool {
+		return compareConcretePaths(a, b) == 0
+	})
+
+	// descendants and higher indexes are deleted first so that the remaining paths stay valid.
+	for _, pathToDelete := range slices.Backward(pathsToDelete) {
+		_, _ = workingObject.delete(path.RecursiveDescentSegments{pathToDelete})
+	}
+
+	return workingObject.GetSourceInterface(), nil
+}
+
+// projectionNode is a node in the tree of the concrete paths selected by Pick.
+type projectionNode struct {
+	// true if the value at the node is selected together with all its descendants.
+	selected bool
+	children map[projectionKey]*projectionNode
+}
+
+// projectionKey is the key (maps and structs) or index (arrays and slices) of a child of a projectionNode.
+type projectionKey struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// projectionTree returns the tree of the concrete paths to the values in `Object.source` at jsonPaths.
+func (n *Object) projectionTree(jsonPaths []path.JSONPath) (*projectionNode, error) {
+	root := &projectionNode{children: make(map[projectionKey]*projectionNode)}
+	for _, jsonPath := range jsonPaths {
+		recursiveDescentSegments, err := parseStrictPath(jsonPath)
+		if err != nil {
+			return nil, err
+		}
+		results, _ := n.getAll(recursiveDescentSegments)
+		for _, result := range results {
+			node := root
+			for _, segment := range result.Path {
+				if segment == nil || segment.IsKeyRoot {
+					continue
+				}
+				key := projectionKey{key: segment.Key}
+				if segment.IsIndex {
+					key = projectionKey{index: segment.Index, isIndex: true}
+				}
+				child, ok := node.children[key]
+				if !ok {
+					child = &projectionNode{children: make(map[projectionKey]*projectionNode)}
+					node.children[key] = child
+				}
+				node = child
+			}
+			node.selected = true
+		}
+	}
+	return root, nil
+}
@@ -0,0 +1,249 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+func TestObject_PickOmit(t *testing.T) {
+	for testData := range PickOmitTestData {
+		original := CloneReflect(reflect.ValueOf(testData.Root)).Interface()
+
+		picked, err := Pick(testData.Root, testData.Paths...)
+		if err != nil || !reflect.DeepEqual(picked, testData.ExpectedPicked) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"picked not equal to testData.ExpectedPicked\n",
+				"picked=", core.JsonStringifyMust(picked), "\n",
+				"testData.ExpectedPicked=", core.JsonStringifyMust(testData.ExpectedPicked), "\n",
+				"err=", err,
+			)
+		}
+
+		omitted, err := Omit(testData.Root, testData.Paths...)
+		if err != nil || !reflect.DeepEqual(omitted, testData.ExpectedOmitted) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"omitted not equal to testData.ExpectedOmitted\n",
+				"omitted=", core.JsonStringifyMust(omitted), "\n",
+				"testData.ExpectedOmitted=", core.JsonStringifyMust(testData.ExpectedOmitted), "\n",
+				"err=", err,
+			)
+		}
+
+		if !reflect.DeepEqual(testData.Root, original) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"source modified\n",
+				"source=", core.JsonStringifyMust(testData.Root),
+			)
+		}
+	}
+}
+
+type PickOmitData struct {
+	internal.TestData
+	Root            any
+	Paths           []path.JSONPath
+	ExpectedPicked  any
+	ExpectedOmitted any
+}
+
+func PickOmitTestData(yield func(data *PickOmitData) bool) {
+	testCaseIndex := 1
+	if !yield(
+		&PickOmitData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Field mask on map", testCaseIndex),
+			},
+			Root: map[string]any{
+				"id":       1,
+				"name":     "Alice",
+				"password": "secret",
+				"address":  map[string]any{"city": "Nairobi", "street": "Main"},
+			},
+			Paths: []path.JSONPath{"$.id", "$.name", "$.address.city"},
+			ExpectedPicked: map[string]any{
+				"id":      1,
+				"name":    "Alice",
+				"address": map[string]any{"city": "Nairobi"},
+			},
+			ExpectedOmitted: map[string]any{
+				"password": "secret",
+				"address":  map[string]any{"street": "Main"},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&PickOmitData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Struct fields zeroed", testCaseIndex),
+			},
+			Root:            &UserProfile{Name: "Alice", Age: 30, Address: Address{Street: "Main", City: "Nairobi"}},
+			Paths:           []path.JSONPath{"$.Name", "$.Address.City"},
+			ExpectedPicked:  &UserProfile{Name: "Alice", Address: Address{City: "Nairobi"}},
+			ExpectedOmitted: &UserProfile{Age: 30, Address: Address{Street: "Main"}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&PickOmitData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Wildcard over slice", testCaseIndex),
+			},
+			Root: map[string]any{
+				"items": []any{
+					map[string]any{"id": 1, "secret": "a"},
+					map[string]any{"id": 2, "secret": "b"},
+				},
+				"total": 2,
+			},
+			Paths: []path.JSONPath{"$.items[*].id"},
+			ExpectedPicked: map[string]any{
+				"items": []any{map[string]any{"id": 1}, map[string]any{"id": 2}},
+			},
+			ExpectedOmitted: map[string]any{
+				"items": []any{map[string]any{"secret": "a"}, map[string]any{"secret": "b"}},
+				"total": 2,
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&PickOmitData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Recursive descent", testCaseIndex),
+			},
+			Root: map[string]any{
+				"a": map[string]any{"price": 1, "name": "x"},
+				"b": []any{map[string]any{"price": 2}, 3},
+			},
+			Paths: []path.JSONPath{"$..price"},
+			ExpectedPicked: map[string]any{
+				"a": map[string]any{"price": 1},
+				"b": []any{map[string]any{"price": 2}, nil},
+			},
+			ExpectedOmitted: map[string]any{
+				"a": map[string]any{"name": "x"},
+				"b": []any{map[string]any{}, 3},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&PickOmitData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Indexes refer to the source", testCaseIndex),
+			},
+			Root:            []any{"a", "b", "c", "d"},
+			Paths:           []path.JSONPath{"$[1]", "$[2]", "$[1]"},
+			ExpectedPicked:  []any{nil, "b", "c", nil},
+			ExpectedOmitted: []any{"a", "d"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&PickOmitData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Paths not found are ignored", testCaseIndex),
+			},
+			Root:            map[string]any{"a": 1},
+			Paths:           []path.JSONPath{"$.b", "$.a.c"},
+			ExpectedPicked:  map[string]any{},
+			ExpectedOmitted: map[string]any{"a": 1},
+		},
+	) {
+		return
+	}
+}
+
+func TestObject_PickSharesNothing(t *testing.T) {
+	source := map[string]any{"tags": []any{"a"}}
+	result, _ := Pick(source, "$.tags")
+	picked := result.(map[string]any)
+	picked["tags"].([]any)[0] = "z"
+	if source["tags"].([]any)[0] != "a" {
+		t.Error("expected picked values to be copies, got=", source)
+	}
+}
+
+func TestObject_PickProjection(t *testing.T) {
+	obj := NewObject().WithSourceInterface(&UserProfile{Name: "Alice", Age: 30, Address: Address{City: "Nairobi"}}).WithSchema(&schema.DynamicSchemaNode{
+		Kind:                    reflect.Pointer,
+		Type:                    reflect.TypeOf(&UserProfile{}),
+		ChildNodesPointerSchema: UserProfileSchema(),
+	})
+
+	projection, projectionSchema, err := obj.PickProjection("$.Name", "$.Address.City")
+	if err != nil {
+		t.Error("expected projection to succeed, got err=", err)
+	}
+	expected := map[string]any{"Name": "Alice", "Address": map[string]any{"City": "Nairobi"}}
+	if !reflect.DeepEqual(projection, expected) {
+		t.Error("expected=", core.JsonStringifyMust(expected), "got=", core.JsonStringifyMust(projection))
+	}
+
+	citySchema, err := schema.GetSchemaAtPath(path.JSONPath("$.Address.City"), projectionSchema)
+	if err != nil || citySchema.Kind != reflect.String {
+		t.Error("expected schema of City in projection schema, got=", citySchema, "err=", err)
+	}
+	if ok, err := schema.NewValidation().ValidateData(projection, projectionSchema); !ok {
+		t.Error("expected projection to be valid against projection schema, err=", err)
+	}
+
+	projection, projectionSchema, err = NewObject().WithSourceInterface(map[string]any{"a": []any{1, map[string]any{"b": 2}}}).PickProjection("$.a[1].b")
+	if err != nil || projectionSchema != nil || !reflect.DeepEqual(projection, map[string]any{"a": []any{nil, map[string]any{"b": 2}}}) {
+		t.Error("expected projection without schema, got=", core.JsonStringifyMust(projection), "schema=", projectionSchema, "err=", err)
+	}
+
+	projection, _, _ = NewObject().WithSourceInterface(&UserProfile{Name: "Alice"}).PickProjection("$")
+	if projection["Name"] != "Alice" || len(projection) != 3 {
+		t.Error("expected root to select each member, got=", core.JsonStringifyMust(projection))
+	}
+
+	if _, _, err := NewObject().WithSourceInterface([]any{1}).PickProjection("$[0]"); !errors.Is(err, ErrValueAtPathSegmentInvalidError) {
+		t.Error("expected error for slice source, got err=", err)
+	}
+}
+
+func TestObject_PickOmit_InvalidPath(t *testing.T) {
+	source := map[string]any{"id": 1, "secret": "s"}
+	for _, jsonPath := range []path.JSONPath{"$.[[[", "$..", "$.id."} {
+		if picked, err := Pick(source, "$.id", jsonPath); !errors.Is(err, ErrPathSegmentInvalidError) || picked != nil {
+			t.Error("expected Pick error for", jsonPath, "got=", core.JsonStringifyMust(picked), "err=", err)
+		}
+		if omitted, err := Omit(source, jsonPath); !errors.Is(err, ErrPathSegmentInvalidError) || omitted != nil {
+			t.Error("expected Omit error for", jsonPath, "got=", core.JsonStringifyMust(omitted), "err=", err)
+		}
+		if projection, _, err := NewObject().WithSourceInterface(source).PickProjection(jsonPath); !errors.Is(err, ErrPathSegmentInvalidError) || projection != nil {
+			t.Error("expected PickProjection error for", jsonPath, "got=", core.JsonStringifyMust(projection), "err=", err)
+		}
+	}
+
+	if picked, err := Pick(source, "/id"); err != nil || !reflect.DeepEqual(picked, map[string]any{"id": 1}) {
+		t.Error("expected JSON Pointer to be supported, got=", core.JsonStringifyMust(picked), "err=", err)
+	}
+}
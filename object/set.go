@@ -16,6 +16,8 @@ Set updates or creates new value(s) in `Object.source`.
 If `Object.schema` is supplied, it gives the function the ability to create user defined collections such as structs at different nesting levels.
 Therefore, the `Object.source` can be instantiated as a value of type any with nil and end up being an array of nested structs.
 
+An index replaces the element, growing the slice if needed. Use `-` as the index to append a new element e.g., `$.items[-].name`, or Object.Insert to shift the elements after the index.
+
 Parameters:
   - jsonPath
   - value - value to insert or replace with.
@@ -28,48 +30,77 @@ func (n *Object) Set(jsonPath path.JSONPath, value any) (uint64, error) {
 
 // SetReflect is the underlying implementation of Set that works with reflect.Value.
 func (n *Object) SetReflect(jsonPath path.JSONPath, value reflect.Value) (uint64, error) {
+	if jsonPath == "" {
+		n.source = value
+		return 1, nil
+	}
+
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, err
+	}
+	return n.set(recursiveDescentSegments, value)
+}
+
+// SetCompiled is like Set but uses a path that has already been compiled with path.Compile.
+func (n *Object) SetCompiled(compiledPath *path.CompiledPath, value any) (uint64, error) {
+	return n.set(compiledPath.Segments(), reflect.ValueOf(value))
+}
+
+// SetReflectCompiled is like SetReflect but uses a path that has already been compiled with path.Compile.
+func (n *Object) SetReflectCompiled(compiledPath *path.CompiledPath, value reflect.Value) (uint64, error) {
+	return n.set(compiledPath.Segments(), value)
+}
+
+// set is the underlying implementation of SetReflect that works with an already parsed path.
+func (n *Object) set(recursiveDescentSegments path.RecursiveDescentSegments, value reflect.Value) (uint64, error) {
+	return n.setOrInsert(recursiveDescentSegments, value, false)
+}
+
+// setOrInsert sets value at recursiveDescentSegments or inserts it at the last index in the path if insert is true.
+func (n *Object) setOrInsert(recursiveDescentSegments path.RecursiveDescentSegments, value reflect.Value, insert bool) (uint64, error) {
 	const FunctionName = "SetReflect"
 
-	if jsonPath == "$" || jsonPath == "" {
+	if isRootPath(recursiveDescentSegments) {
 		n.source = value
 		return 1, nil
 	}
 
-	n.noOfResults = 0
-	n.lastError = nil
-	n.recursiveDescentSegments = jsonPath.Parse()
-	n.valueToSet = value
-
 	currentPathSegmentIndexes := internal.PathSegmentsIndexes{
 		CurrentRecursive: 0,
-		LastRecursive:    len(n.recursiveDescentSegments) - 1,
+		LastRecursive:    len(recursiveDescentSegments) - 1,
 	}
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive {
-		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.source.Interface()})
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
 	}
 	currentPathSegmentIndexes.CurrentCollection = 0
-	currentPathSegmentIndexes.LastCollection = len(n.recursiveDescentSegments[0]) - 1
+	currentPathSegmentIndexes.LastCollection = len(recursiveDescentSegments[0]) - 1
 	if currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
-		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.source.Interface()})
+		return 0, NewError().WithFunctionName(FunctionName).WithMessage("recursiveDescentSegments empty").WithNestedError(ErrPathSegmentInvalidError).WithData(core.JsonObject{"Source": n.GetSourceInterface()})
 	}
 
+	t := n.newTraversal(recursiveDescentSegments)
+	t.valueToSet = value
+	t.insert = insert
 	if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-		n.source = n.recursiveSet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]}, n.sourceType)
+		n.source = t.recursiveSet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]}, n.sourceType)
 	} else {
-		n.source = n.recursiveDescentSet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]})
+		n.source = t.recursiveDescentSet(n.source, currentPathSegmentIndexes, path.RecursiveDescentSegment{recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]})
 	}
 
-	if n.noOfResults > 0 {
-		return n.noOfResults, nil
-	}
-	return n.noOfResults, n.lastError
+	return t.result()
 }
 
 // recursiveSet traverses the object to find the location to set the value.
 // It handles creation of intermediate nodes if a schema is provided.
-func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment, currentValueType reflect.Type) reflect.Value {
+func (n *traversal) recursiveSet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment, currentValueType reflect.Type) (modifiedValue reflect.Value) {
 	const FunctionName = "recursiveSet"
 
+	if n.copyOnWrite {
+		defer n.keepIfUnmodified(currentValue, n.noOfResults, &modifiedValue)
+		currentValue = shallowCopy(currentValue)
+	}
+
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive || currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
 		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage("indexes empty").
 			WithNestedError(ErrPathSegmentInvalidError).
@@ -111,6 +142,12 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 	}
 
 	if core.IsNilOrInvalid(currentValue) {
+		if hasFilterSelector(recursiveSegment) {
+			// nothing to filter, so do not create a new collection.
+			n.resolveFilterSelector(currentValue, recursiveSegment, currentPath)
+			return currentValue
+		}
+
 		if newValue, err := n.getDefaultValueAtPathSegment(currentValue, currentPathSegmentIndexes, currentPath, currentValueType); err == nil {
 			currentValue = newValue
 		} else {
@@ -136,9 +173,16 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 		return currentValue
 	}
 
+	if hasFilterSelector(recursiveSegment) {
+		var ok bool
+		if recursiveSegment, ok = n.resolveFilterSelector(currentValue, recursiveSegment, currentPath); !ok {
+			return currentValue
+		}
+	}
+
 	if mapKeyType, mapValueType, ok := core.GetMapKeyValueType(currentValue); ok {
 		if recursiveSegment.IsKey {
-			mapEntrySchema, _ := schema.GetSchemaAtPath(append(currentPath, recursiveSegment), n.schema)
+			mapEntrySchema, _ := n.schemaAtPath(append(currentPath, recursiveSegment))
 			if mapEntrySchema == nil {
 				mapEntrySchema = &schema.DynamicSchemaNode{
 					Kind: mapValueType.Kind(),
@@ -210,7 +254,7 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 					Key:   mapKeyString(mapKey),
 				}
 
-				mapEntrySchema, _ := schema.GetSchemaAtPath(append(currentPath, mapKeyPathSegment), n.schema)
+				mapEntrySchema, _ := n.schemaAtPath(append(currentPath, mapKeyPathSegment))
 				if mapEntrySchema == nil {
 					mapEntrySchema = &schema.DynamicSchemaNode{
 						Kind: mapValueType.Kind(),
@@ -259,7 +303,7 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 					continue
 				}
 
-				mapEntrySchema, _ := schema.GetSchemaAtPath(append(currentPath, unionKey), n.schema)
+				mapEntrySchema, _ := n.schemaAtPath(append(currentPath, unionKey))
 				if mapEntrySchema == nil {
 					mapEntrySchema = &schema.DynamicSchemaNode{
 						Kind: mapValueType.Kind(),
@@ -328,6 +372,10 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 				WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
 		}
 	} else if arraySliceType, ok := core.GetArraySliceValueType(currentValue); ok {
+		if recursiveSegment.IsIndexEnd || (n.insert && recursiveSegment.IsIndex && currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection && currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive) {
+			return n.insertIntoArraySlice(currentValue, currentPathSegmentIndexes, currentPath, recursiveSegment, arraySliceType)
+		}
+
 		if recursiveSegment.IsIndex {
 			if recursiveSegment.Index > currentValue.Len()-1 && currentValue.Kind() == reflect.Slice {
 				for i := currentValue.Len(); i <= recursiveSegment.Index; i++ {
@@ -335,16 +383,17 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 				}
 			}
 
-			if recursiveSegment.Index >= currentValue.Len() {
+			if index, ok := path.NormalizeIndex(recursiveSegment.Index, currentValue.Len()); !ok {
 				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in array/slice, index %s out of range", recursiveSegment)).
 					WithNestedError(ErrValueAtPathSegmentInvalidError).
 					WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
 			} else {
-				arraySliceValue := currentValue.Index(recursiveSegment.Index)
+				recursiveSegment = &path.CollectionMemberSegment{IsIndex: true, Index: index}
+				arraySliceValue := currentValue.Index(index)
 				if arraySliceValue.CanSet() {
 					if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 						if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-							arraySliceSchema, _ := schema.GetSchemaAtPath(append(currentPath, recursiveSegment), n.schema)
+							arraySliceSchema, _ := n.schemaAtPath(append(currentPath, recursiveSegment))
 							if err := n.convertSourceToTargetType(n.valueToSet, arraySliceSchema, arraySliceType, arraySliceValue); err == nil {
 								n.noOfResults++
 							}
@@ -390,10 +439,11 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 					collectionMemberSegment := &path.CollectionMemberSegment{IsIndex: true, Index: i}
 					if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 						if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-							arraySliceSchema, _ := schema.GetSchemaAtPath(append(currentPath, collectionMemberSegment), n.schema)
+							arraySliceSchema, _ := n.schemaAtPath(append(currentPath, collectionMemberSegment))
 							if err := n.convertSourceToTargetType(n.valueToSet, arraySliceSchema, arraySliceValue.Type(), arraySliceValue); err == nil {
 								n.noOfResults++
 							}
+							continue
 						}
 
 						recursiveDescentIndexes := internal.PathSegmentsIndexes{
@@ -440,21 +490,27 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 			}
 
 			for _, unionKey := range recursiveSegment.UnionSelector {
-				if !unionKey.IsIndex || unionKey.Index >= currentValue.Len() {
+				if !unionKey.IsIndex {
 					continue
 				}
+				index, ok := path.NormalizeIndex(unionKey.Index, currentValue.Len())
+				if !ok {
+					continue
+				}
+				unionKey = &path.CollectionMemberSegment{IsIndex: true, Index: index}
 
-				arraySliceValue := currentValue.Index(unionKey.Index)
+				arraySliceValue := currentValue.Index(index)
 				if !arraySliceValue.CanSet() || !arraySliceValue.IsValid() {
 					continue
 				}
 
 				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						arraySliceSchema, _ := schema.GetSchemaAtPath(append(currentPath, unionKey), n.schema)
+						arraySliceSchema, _ := n.schemaAtPath(append(currentPath, unionKey))
 						if err := n.convertSourceToTargetType(n.valueToSet, arraySliceSchema, arraySliceValue.Type(), arraySliceValue); err == nil {
 							n.noOfResults++
 						}
+						continue
 					}
 
 					recursiveDescentIndexes := internal.PathSegmentsIndexes{
@@ -480,30 +536,15 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 				arraySliceValue.Set(recursiveValue)
 			}
 		} else if recursiveSegment.LinearCollectionSelector != nil {
-			start := 0
-			if recursiveSegment.LinearCollectionSelector.IsStart {
-				start = recursiveSegment.LinearCollectionSelector.Start
-			}
-			step := 1
-			if recursiveSegment.LinearCollectionSelector.IsStep && recursiveSegment.LinearCollectionSelector.Step > 0 {
-				step = recursiveSegment.LinearCollectionSelector.Step
-			}
-			end := currentValue.Len()
-			if recursiveSegment.LinearCollectionSelector.IsEnd {
-				end = recursiveSegment.LinearCollectionSelector.End
-			}
-
-			if end > currentValue.Len() && currentValue.Kind() == reflect.Slice {
-				for i := currentValue.Len(); i <= end; i++ {
+			// grow the slice if a positive End beyond the current length is selected in ascending order.
+			linearCollectionSelector := recursiveSegment.LinearCollectionSelector
+			if (!linearCollectionSelector.IsStep || linearCollectionSelector.Step > 0) && linearCollectionSelector.IsEnd && linearCollectionSelector.End > currentValue.Len() && currentValue.Kind() == reflect.Slice {
+				for i := currentValue.Len(); i <= linearCollectionSelector.End; i++ {
 					currentValue = reflect.Append(currentValue, reflect.Zero(arraySliceType))
 				}
 			}
 
-			for i := start; i < end; i += step {
-				if i >= currentValue.Len() {
-					continue
-				}
-
+			for _, i := range linearCollectionSelector.Indices(currentValue.Len()) {
 				arraySliceValue := currentValue.Index(i)
 				if !arraySliceValue.CanSet() || !arraySliceValue.IsValid() {
 					continue
@@ -512,10 +553,11 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 				collectionMemberSegment := &path.CollectionMemberSegment{IsIndex: true, Index: i}
 				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						arraySliceSchema, _ := schema.GetSchemaAtPath(append(currentPath, collectionMemberSegment), n.schema)
+						arraySliceSchema, _ := n.schemaAtPath(append(currentPath, collectionMemberSegment))
 						if err := n.convertSourceToTargetType(n.valueToSet, arraySliceSchema, arraySliceValue.Type(), arraySliceValue); err == nil {
 							n.noOfResults++
 						}
+						continue
 					}
 
 					recursiveDescentIndexes := internal.PathSegmentsIndexes{
@@ -546,17 +588,17 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 				WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
 		}
 	} else if currentValue.Kind() == reflect.Struct {
+		currentValue = settableCopy(currentValue)
 		if recursiveSegment.IsKey {
-			if !core.StartsWithCapital(recursiveSegment.Key) {
-				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("key %v is not valid for struct", recursiveSegment)).
+			if structFieldValue, ok := n.settableStructField(currentValue, recursiveSegment.Key); !ok {
+				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(n.invalidStructFieldMessage(currentValue, recursiveSegment)).
 					WithNestedError(ErrPathSegmentInvalidError).
 					WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
 			} else {
-				structFieldValue := currentValue.FieldByName(recursiveSegment.Key)
 				if structFieldValue.CanSet() {
 					if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 						if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-							structFieldSchema, _ := schema.GetSchemaAtPath(append(currentPath, recursiveSegment), n.schema)
+							structFieldSchema, _ := n.schemaAtPath(append(currentPath, recursiveSegment))
 							if err := n.convertSourceToTargetType(n.valueToSet, structFieldSchema, structFieldValue.Type(), structFieldValue); err == nil {
 								n.noOfResults++
 							}
@@ -585,18 +627,18 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 				}
 			}
 		} else if recursiveSegment.IsKeyIndexAll {
-			for i := 0; i < currentValue.NumField(); i++ {
-				structField := currentValue.Field(i)
+			for _, structFieldName := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+				structField := structFieldName.Value(currentValue)
 
 				if !structField.CanSet() {
 					continue
 				}
 
-				structFieldSegment := &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name}
+				structFieldSegment := &path.CollectionMemberSegment{IsKey: true, Key: structFieldName.Name}
 
 				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						structFieldSchema, _ := schema.GetSchemaAtPath(append(currentPath, structFieldSegment), n.schema)
+						structFieldSchema, _ := n.schemaAtPath(append(currentPath, structFieldSegment))
 						if err := n.convertSourceToTargetType(n.valueToSet, structFieldSchema, structField.Type(), structField); err == nil {
 							n.noOfResults++
 						}
@@ -627,18 +669,18 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 			}
 		} else if len(recursiveSegment.UnionSelector) > 0 {
 			for _, unionKey := range recursiveSegment.UnionSelector {
-				if !unionKey.IsKey || !core.StartsWithCapital(unionKey.Key) {
+				if !unionKey.IsKey {
 					continue
 				}
 
-				structFieldValue := currentValue.FieldByName(unionKey.Key)
-				if !structFieldValue.CanSet() {
+				structFieldValue, ok := n.settableStructField(currentValue, unionKey.Key)
+				if !ok || !structFieldValue.CanSet() {
 					continue
 				}
 
 				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						structFieldSchema, _ := schema.GetSchemaAtPath(append(currentPath, unionKey), n.schema)
+						structFieldSchema, _ := n.schemaAtPath(append(currentPath, unionKey))
 						if err := n.convertSourceToTargetType(n.valueToSet, structFieldSchema, structFieldValue.Type(), structFieldValue); err == nil {
 							n.noOfResults++
 						}
@@ -683,10 +725,10 @@ func (n *Object) recursiveSet(currentValue reflect.Value, currentPathSegmentInde
 
 // getDefaultValueAtPathSegment attempts to create a new zero-value for the current path segment.
 // It uses the Schema if available to determine the correct type (e.g. specific struct vs generic map).
-func (n *Object) getDefaultValueAtPathSegment(value reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment, valueType reflect.Type) (reflect.Value, error) {
+func (n *traversal) getDefaultValueAtPathSegment(value reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment, valueType reflect.Type) (reflect.Value, error) {
 	const FunctionName = "getDefaultValueAtPathSegment"
 
-	valueSchema, err := schema.GetSchemaAtPath(currentPath, n.schema)
+	valueSchema, err := n.schemaAtPath(currentPath)
 	if err == nil {
 		if valueSchema.IsDefaultValueSet {
 			return valueSchema.DefaultValue(), nil
@@ -706,7 +748,7 @@ func (n *Object) getDefaultValueAtPathSegment(value reflect.Value, currentPathSe
 				WithData(core.JsonObject{"CurrentValue": value.Interface(), "CurrentPathSegment": currentPath})
 		}
 
-		if currentPathSegment.IsIndex || (len(currentPathSegment.UnionSelector) > 0 && currentPathSegment.UnionSelector[0].IsIndex) || currentPathSegment.LinearCollectionSelector != nil {
+		if currentPathSegment.IsIndex || currentPathSegment.IsIndexEnd || (len(currentPathSegment.UnionSelector) > 0 && currentPathSegment.UnionSelector[0].IsIndex) || currentPathSegment.LinearCollectionSelector != nil {
 			newValue = reflect.MakeSlice(reflect.TypeOf(make([]any, 0)), 0, 0)
 		} else {
 			// Define the reflect.Type for the key (string)
@@ -730,7 +772,7 @@ func (n *Object) getDefaultValueAtPathSegment(value reflect.Value, currentPathSe
 					WithData(core.JsonObject{"CurrentValue": value.Interface(), "CurrentPathSegment": currentPath})
 			}
 
-			if currentPathSegment.IsIndex || (len(currentPathSegment.UnionSelector) > 0 && currentPathSegment.UnionSelector[0].IsIndex) || currentPathSegment.LinearCollectionSelector != nil {
+			if currentPathSegment.IsIndex || currentPathSegment.IsIndexEnd || (len(currentPathSegment.UnionSelector) > 0 && currentPathSegment.UnionSelector[0].IsIndex) || currentPathSegment.LinearCollectionSelector != nil {
 				newValue = reflect.MakeSlice(reflect.TypeOf(make([]any, 0)), 0, 0)
 			} else {
 				newValue = reflect.ValueOf(map[string]any{})
@@ -756,11 +798,94 @@ func (n *Object) getDefaultValueAtPathSegment(value reflect.Value, currentPathSe
 	return newValue, nil
 }
 
+/*
+insertIntoArraySlice inserts a new element into the slice currentValue at the index in recursiveSegment shifting the elements after it, or appends it if recursiveSegment is `-`.
+
+A negative index counts from the end hence `[-1]` inserts before the last element. If recursiveSegment is not the last segment in the path,
+the rest of the path is set in a new zero value e.g., `$.items[-].name` appends an item with a name.
+
+Returns currentValue unchanged if nothing was set in the new element. Arrays cannot be inserted into as their length is fixed.
+*/
+func (n *traversal) insertIntoArraySlice(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment, recursiveSegment *path.CollectionMemberSegment, arraySliceType reflect.Type) reflect.Value {
+	const FunctionName = "insertIntoArraySlice"
+
+	if currentValue.Kind() == reflect.Array {
+		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in array, cannot insert at %s as the length is fixed", recursiveSegment)).
+			WithNestedError(ErrValueAtPathSegmentInvalidError).
+			WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
+		return currentValue
+	}
+
+	index := currentValue.Len()
+	if recursiveSegment.IsIndex {
+		index = recursiveSegment.Index
+		if index < 0 {
+			index += currentValue.Len()
+		}
+		if index < 0 || index > currentValue.Len() {
+			n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in slice, index %s out of range", recursiveSegment)).
+				WithNestedError(ErrValueAtPathSegmentInvalidError).
+				WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath, "Length": currentValue.Len()})
+			return currentValue
+		}
+	}
+
+	newValue := reflect.MakeSlice(currentValue.Type(), 0, currentValue.Len()+1)
+	newValue = reflect.AppendSlice(newValue, currentValue.Slice(0, index))
+	newValue = reflect.Append(newValue, reflect.Zero(arraySliceType))
+	newValue = reflect.AppendSlice(newValue, currentValue.Slice(index, currentValue.Len()))
+
+	noOfResults := n.noOfResults
+	collectionMemberSegment := &path.CollectionMemberSegment{IsIndex: true, Index: index}
+	arraySliceValue := newValue.Index(index)
+	if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
+		if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
+			arraySliceSchema, _ := n.schemaAtPath(append(currentPath, collectionMemberSegment))
+			if err := n.convertSourceToTargetType(n.valueToSet, arraySliceSchema, arraySliceType, arraySliceValue); err != nil {
+				n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("in slice, convert value to insert at %s failed", recursiveSegment)).
+					WithNestedError(err).
+					WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
+				return currentValue
+			}
+			n.noOfResults++
+			return newValue
+		}
+
+		recursiveDescentIndexes := internal.PathSegmentsIndexes{
+			CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
+			LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+			CurrentCollection: 0,
+			LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
+		}
+
+		arraySliceValue.Set(n.recursiveDescentSet(arraySliceValue, recursiveDescentIndexes, append(currentPath, collectionMemberSegment)))
+	} else {
+		recursiveIndexes := internal.PathSegmentsIndexes{
+			CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+			LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+			CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
+			LastCollection:    currentPathSegmentIndexes.LastCollection,
+		}
+
+		arraySliceValue.Set(n.recursiveSet(arraySliceValue, recursiveIndexes, append(currentPath, collectionMemberSegment), arraySliceType))
+	}
+
+	if n.noOfResults == noOfResults {
+		return currentValue
+	}
+	return newValue
+}
+
 // recursiveDescentSet handles setting values when the path involves recursive descent ('..').
 // Note: Setting values via recursive descent can modify multiple locations in the object tree.
-func (n *Object) recursiveDescentSet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+func (n *traversal) recursiveDescentSet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) (modifiedValue reflect.Value) {
 	const FunctionName = "recursiveDescentSet"
 
+	if n.copyOnWrite {
+		defer n.keepIfUnmodified(currentValue, n.noOfResults, &modifiedValue)
+		currentValue = shallowCopy(currentValue)
+	}
+
 	if currentPathSegmentIndexes.CurrentRecursive > currentPathSegmentIndexes.LastRecursive || currentPathSegmentIndexes.CurrentCollection > currentPathSegmentIndexes.LastCollection {
 		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage("indexes empty").
 			WithNestedError(ErrPathSegmentInvalidError).
@@ -787,13 +912,6 @@ func (n *Object) recursiveDescentSet(currentValue reflect.Value, currentPathSegm
 		return n.recursiveSet(currentValue, currentPathSegmentIndexes, currentPath, currentValue.Type())
 	}
 
-	if !recursiveDescentSearchSegment.IsKey {
-		n.lastError = NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("recursive descent search segment %s is not key", recursiveDescentSearchSegment)).
-			WithNestedError(ErrPathSegmentInvalidError).
-			WithData(core.JsonObject{"CurrentValue": currentValue.Interface(), "CurrentPathSegment": currentPath})
-		return currentValue
-	}
-
 	if currentValue.Kind() == reflect.Interface {
 		return n.recursiveDescentSet(currentValue.Elem(), currentPathSegmentIndexes, currentPath)
 	}
@@ -804,6 +922,10 @@ func (n *Object) recursiveDescentSet(currentValue reflect.Value, currentPathSegm
 		return currentValue
 	}
 
+	if !recursiveDescentSearchSegment.IsKey {
+		return n.recursiveDescentSelectorSet(currentValue, currentPathSegmentIndexes, currentPath)
+	}
+
 	if _, mapValueType, ok := core.GetMapKeyValueType(currentValue); ok {
 		for _, mapKey := range currentValue.MapKeys() {
 			mapValue := currentValue.MapIndex(mapKey)
@@ -819,7 +941,7 @@ func (n *Object) recursiveDescentSet(currentValue reflect.Value, currentPathSegm
 			if keyPathSegment.Key == recursiveDescentSearchSegment.Key {
 				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						mapValueSchema, _ := schema.GetSchemaAtPath(append(currentPath, recursiveDescentSearchSegment), n.schema)
+						mapValueSchema, _ := n.schemaAtPath(append(currentPath, recursiveDescentSearchSegment))
 						newMapValue := reflect.New(mapValueType).Elem()
 						if err := n.convertSourceToTargetType(n.valueToSet, mapValueSchema, mapValueType, newMapValue); err == nil {
 							currentValue.SetMapIndex(mapKey, newMapValue)
@@ -865,51 +987,46 @@ func (n *Object) recursiveDescentSet(currentValue reflect.Value, currentPathSegm
 			}
 		}
 	} else if currentValue.Kind() == reflect.Struct {
-		if core.StartsWithCapital(recursiveDescentSearchSegment.Key) {
-			if structFieldValue := currentValue.FieldByName(recursiveDescentSearchSegment.Key); structFieldValue.IsValid() && structFieldValue.CanSet() {
-				if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
-					if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
-						structFieldSchema, _ := schema.GetSchemaAtPath(append(currentPath, recursiveDescentSearchSegment), n.schema)
-						if err := n.convertSourceToTargetType(n.valueToSet, structFieldSchema, structFieldValue.Type(), structFieldValue); err == nil {
-							n.noOfResults++
-						}
-					} else {
-						recursiveDescentIndexes := internal.PathSegmentsIndexes{
-							CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
-							LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-							CurrentCollection: 0,
-							LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
-						}
-
-						recursiveDescentValue := n.recursiveDescentSet(structFieldValue, recursiveDescentIndexes, append(currentPath, recursiveDescentSearchSegment))
-						structFieldValue.Set(recursiveDescentValue)
+		currentValue = settableCopy(currentValue)
+		if structFieldValue, _, ok := n.structField(currentValue, recursiveDescentSearchSegment.Key); ok && structFieldValue.IsValid() && structFieldValue.CanSet() {
+			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
+				if currentPathSegmentIndexes.CurrentRecursive == currentPathSegmentIndexes.LastRecursive {
+					structFieldSchema, _ := n.schemaAtPath(append(currentPath, recursiveDescentSearchSegment))
+					if err := n.convertSourceToTargetType(n.valueToSet, structFieldSchema, structFieldValue.Type(), structFieldValue); err == nil {
+						n.noOfResults++
 					}
 				} else {
-					recursiveIndexes := internal.PathSegmentsIndexes{
-						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					recursiveDescentIndexes := internal.PathSegmentsIndexes{
+						CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive + 1,
 						LastRecursive:     currentPathSegmentIndexes.LastRecursive,
-						CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
-						LastCollection:    currentPathSegmentIndexes.LastCollection,
+						CurrentCollection: 0,
+						LastCollection:    len(n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive+1]) - 1,
 					}
 
-					recursiveValue := n.recursiveSet(structFieldValue, recursiveIndexes, append(currentPath, recursiveDescentSearchSegment), structFieldValue.Type())
-					structFieldValue.Set(recursiveValue)
+					recursiveDescentValue := n.recursiveDescentSet(structFieldValue, recursiveDescentIndexes, append(currentPath, recursiveDescentSearchSegment))
+					structFieldValue.Set(recursiveDescentValue)
+				}
+			} else {
+				recursiveIndexes := internal.PathSegmentsIndexes{
+					CurrentRecursive:  currentPathSegmentIndexes.CurrentRecursive,
+					LastRecursive:     currentPathSegmentIndexes.LastRecursive,
+					CurrentCollection: currentPathSegmentIndexes.CurrentCollection + 1,
+					LastCollection:    currentPathSegmentIndexes.LastCollection,
 				}
-			}
-		}
 
-		for i := 0; i < currentValue.NumField(); i++ {
-			if !core.IsStructFieldExported(currentValue.Type().Field(i)) {
-				continue
+				recursiveValue := n.recursiveSet(structFieldValue, recursiveIndexes, append(currentPath, recursiveDescentSearchSegment), structFieldValue.Type())
+				structFieldValue.Set(recursiveValue)
 			}
+		}
 
-			structFieldValue := currentValue.Field(i)
+		for _, structField := range n.fieldNameStrategy.Fields(currentValue.Type()) {
+			structFieldValue := structField.Value(currentValue)
 			if !structFieldValue.IsValid() {
 				continue
 			}
 
 			if structFieldValue.CanSet() {
-				recursiveDescentValue := n.recursiveDescentSet(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: currentValue.Type().Field(i).Name}))
+				recursiveDescentValue := n.recursiveDescentSet(structFieldValue, currentPathSegmentIndexes, append(currentPath, &path.CollectionMemberSegment{IsKey: true, Key: structField.Name}))
 				structFieldValue.Set(recursiveDescentValue)
 			}
 		}
@@ -922,15 +1039,54 @@ func (n *Object) recursiveDescentSet(currentValue reflect.Value, currentPathSegm
 	return currentValue
 }
 
+/*
+recursiveDescentSelectorSet handles a recursive descent search segment that is not a key e.g., `$..[?@.price < 10]` or `$..*`.
+
+The descendants of currentValue are updated first before the selector is applied to currentValue itself.
+Unlike recursiveSet, new array/slice elements are not created for indexes that are out of range.
+*/
+func (n *traversal) recursiveDescentSelectorSet(currentValue reflect.Value, currentPathSegmentIndexes internal.PathSegmentsIndexes, currentPath path.RecursiveDescentSegment) reflect.Value {
+	for _, member := range getCollectionMembers(currentValue, n.fieldNameStrategy) {
+		recursiveDescentValue := n.recursiveDescentSet(member.value, currentPathSegmentIndexes, append(currentPath, member.segment))
+		if member.mapKey.IsValid() {
+			currentValue.SetMapIndex(member.mapKey, recursiveDescentValue)
+		} else if member.value.CanSet() {
+			member.value.Set(recursiveDescentValue)
+		}
+	}
+
+	if !isCollection(currentValue) || selectorExceedsLength(currentValue, n.recursiveDescentSegments[currentPathSegmentIndexes.CurrentRecursive][currentPathSegmentIndexes.CurrentCollection]) {
+		return currentValue
+	}
+
+	return n.recursiveSet(currentValue, currentPathSegmentIndexes, currentPath, currentValue.Type())
+}
+
+// selectorExceedsLength returns true if the index or union selector in pathSegment refers to an index beyond the end of the array/slice currentValue.
+func selectorExceedsLength(currentValue reflect.Value, pathSegment *path.CollectionMemberSegment) bool {
+	if _, ok := core.GetArraySliceValueType(currentValue); !ok {
+		return false
+	}
+
+	if pathSegment.IsIndex {
+		return pathSegment.Index >= currentValue.Len()
+	}
+
+	for _, unionKey := range pathSegment.UnionSelector {
+		if unionKey.IsIndex && unionKey.Index >= currentValue.Len() {
+			return true
+		}
+	}
+	return false
+}
+
 // convertSourceToTargetType uses the default converter to coerce the valueToSet into the target type defined by the schema or reflection.
-func (n *Object) convertSourceToTargetType(source reflect.Value, sourceSchema *schema.DynamicSchemaNode, sourceType reflect.Type, destination reflect.Value) error {
+func (n *traversal) convertSourceToTargetType(source reflect.Value, sourceSchema *schema.DynamicSchemaNode, sourceType reflect.Type, destination reflect.Value) error {
 	const FunctionName = "convertSourceToTargetType"
 
 	if (sourceSchema == nil || sourceSchema.Kind == reflect.Interface) && sourceType != nil {
-		sourceSchema = &schema.DynamicSchemaNode{
-			Kind: sourceType.Kind(),
-			Type: sourceType,
-		}
+		// typeSchema so that composite values e.g., a []any decoded from JSON, can be converted to a []Address.
+		sourceSchema = typeSchema(sourceType, make(map[reflect.Type]*schema.DynamicSchemaNode))
 	}
 
 	if sourceSchema != nil {
@@ -603,4 +603,152 @@ func SetTestData(yield func(data *SetData) bool) {
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with filter selector", testCaseIndex),
+			},
+			Root: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 1, "status": "open", "total": 150},
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+					map[string]any{"id": 4, "status": "open", "total": 120.5},
+				},
+			},
+			Path:       "$.orders[?(@.status == 'open' && @.total > 100)].status",
+			ValueToSet: "priority",
+			ExpectedOk: 2,
+			ExpectedValue: map[string]any{
+				"orders": []any{
+					map[string]any{"id": 1, "status": "priority", "total": 150},
+					map[string]any{"id": 2, "status": "closed", "total": 300},
+					map[string]any{"id": 3, "status": "open", "total": 50},
+					map[string]any{"id": 4, "status": "priority", "total": 120.5},
+				},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with filter selector in recursive descent", testCaseIndex),
+			},
+			Root: []*User{
+				{ID: 1, Name: "Alice"},
+				{ID: 2, Name: "Bob"},
+			},
+			Path:       "$..[?@.ID == 2].Email",
+			ValueToSet: "bob@example.com",
+			ExpectedOk: 1,
+			ExpectedValue: []*User{
+				{ID: 1, Name: "Alice"},
+				{ID: 2, Name: "Bob", Email: "bob@example.com"},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with filter selector and no matches", testCaseIndex),
+			},
+			Root:          map[string]any{"orders": []any{map[string]any{"total": 1}}},
+			Path:          "$.orders[?@.total > 100].status",
+			ValueToSet:    "priority",
+			ExpectedOk:    0,
+			ExpectedValue: map[string]any{"orders": []any{map[string]any{"total": 1}}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set last element of a fixed-size array with a negative index", testCaseIndex),
+			},
+			Root:          &[3]int{1, 2, 3},
+			Path:          "$[-1]",
+			ValueToSet:    30,
+			ExpectedOk:    1,
+			ExpectedValue: &[3]int{1, 2, 30},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with negative step slice", testCaseIndex),
+			},
+			Root:          map[string]any{"items": []int{1, 2, 3, 4, 5}},
+			Path:          "$.items[:-4:-2]",
+			ValueToSet:    0,
+			ExpectedOk:    2,
+			ExpectedValue: map[string]any{"items": []int{1, 2, 0, 4, 0}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with negative index out of range does not grow the slice", testCaseIndex),
+			},
+			Root:          []int{1},
+			Path:          "$[-2]",
+			ValueToSet:    0,
+			ExpectedOk:    0,
+			ExpectedValue: []int{1},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with JSON Pointer", testCaseIndex),
+			},
+			Root:          map[string]any{"data": map[string]any{"items": []any{map[string]any{"name": "first"}}}},
+			Path:          "/data/items/0/name",
+			ValueToSet:    "updated",
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"data": map[string]any{"items": []any{map[string]any{"name": "updated"}}}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&SetData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Set with JSON Pointer creating nested collections", testCaseIndex),
+			},
+			Root:          map[string]any{},
+			Path:          "/a~1b/items/0",
+			ValueToSet:    1,
+			ExpectedOk:    1,
+			ExpectedValue: map[string]any{"a/b": map[string]any{"items": []any{1}}},
+		},
+	) {
+		return
+	}
 }
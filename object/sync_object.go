@@ -0,0 +1,310 @@
+package object
+
+import (
+	"iter"
+	"reflect"
+	"sync"
+
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+/*
+SyncObject wraps an Object so that it can be shared by goroutines that read and modify the source.
+
+Get, GetAll, ForEach, All, Pick, Omit, and PickProjection hold a read lock hence they run concurrently with each other.
+Set, Insert, Append, Delete, Update, DeleteWhere, RetainWhere, ApplyPatch, MergePatch, Batch, Restore, and Undo hold a write lock.
+The methods that take a path.CompiledPath hold the same lock as the methods they are compiled versions of.
+
+Other Object methods such as Iterate, whose Iterator outlives the call, must be called inside Read or Write and only used until the callback returns.
+
+Values returned by the read methods may share memory with the source e.g., a map or slice.
+Use Read to work with such values while the read lock is held.
+
+The callbacks passed to ForEach, Update, DeleteWhere, RetainWhere, Batch, Read, and Write, and the body of a loop over All, must not call other SyncObject methods
+as the lock is not reentrant.
+
+Usage:
+
+	syncObject := NewSyncObject(NewObject().WithSourceInterface(source))
+
+	go syncObject.Set("$.status", "active")
+
+	valueFound, noOfResults, err := syncObject.Get("$.status")
+
+	syncObject.Write(func(obj *Object) {
+		// several modifications made atomically
+	})
+*/
+type SyncObject struct {
+	mutex  sync.RWMutex
+	object *Object
+}
+
+// Get is Object.Get with a read lock.
+func (n *SyncObject) Get(jsonPath path.JSONPath) (any, uint64, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.Get(jsonPath)
+}
+
+// GetReflect is Object.GetReflect with a read lock.
+func (n *SyncObject) GetReflect(jsonPath path.JSONPath) (reflect.Value, uint64, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.GetReflect(jsonPath)
+}
+
+// GetCompiled is Object.GetCompiled with a read lock.
+func (n *SyncObject) GetCompiled(compiledPath *path.CompiledPath) (any, uint64, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.GetCompiled(compiledPath)
+}
+
+// GetAll is Object.GetAll with a read lock.
+func (n *SyncObject) GetAll(jsonPath path.JSONPath) ([]QueryResult, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.GetAll(jsonPath)
+}
+
+// GetAllCompiled is Object.GetAllCompiled with a read lock.
+func (n *SyncObject) GetAllCompiled(compiledPath *path.CompiledPath) ([]QueryResult, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.GetAllCompiled(compiledPath)
+}
+
+// ForEach is Object.ForEach with a read lock held until the loop ends.
+func (n *SyncObject) ForEach(jsonPath path.JSONPath, ifValueFoundInObject IfValueFoundInObject) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	n.object.ForEach(jsonPath, ifValueFoundInObject)
+}
+
+// ForEachCompiled is Object.ForEachCompiled with a read lock held until the loop ends.
+func (n *SyncObject) ForEachCompiled(compiledPath *path.CompiledPath, ifValueFoundInObject IfValueFoundInObject) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	n.object.ForEachCompiled(compiledPath, ifValueFoundInObject)
+}
+
+// All is Object.All with a read lock held from the start of the loop until it ends.
+func (n *SyncObject) All(jsonPath path.JSONPath) iter.Seq2[path.RecursiveDescentSegment, reflect.Value] {
+	return func(yield func(path.RecursiveDescentSegment, reflect.Value) bool) {
+		n.mutex.RLock()
+		defer n.mutex.RUnlock()
+		n.object.All(jsonPath)(yield)
+	}
+}
+
+// AllCompiled is Object.AllCompiled with a read lock held from the start of the loop until it ends.
+func (n *SyncObject) AllCompiled(compiledPath *path.CompiledPath) iter.Seq2[path.RecursiveDescentSegment, reflect.Value] {
+	return func(yield func(path.RecursiveDescentSegment, reflect.Value) bool) {
+		n.mutex.RLock()
+		defer n.mutex.RUnlock()
+		n.object.AllCompiled(compiledPath)(yield)
+	}
+}
+
+// Pick is Object.Pick with a read lock.
+func (n *SyncObject) Pick(jsonPaths ...path.JSONPath) (any, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.Pick(jsonPaths...)
+}
+
+// Omit is Object.Omit with a read lock.
+func (n *SyncObject) Omit(jsonPaths ...path.JSONPath) (any, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.Omit(jsonPaths...)
+}
+
+// PickProjection is Object.PickProjection with a read lock.
+func (n *SyncObject) PickProjection(jsonPaths ...path.JSONPath) (map[string]any, *schema.DynamicSchemaNode, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.PickProjection(jsonPaths...)
+}
+
+// Set is Object.Set with a write lock.
+func (n *SyncObject) Set(jsonPath path.JSONPath, value any) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Set(jsonPath, value)
+}
+
+// SetReflect is Object.SetReflect with a write lock.
+func (n *SyncObject) SetReflect(jsonPath path.JSONPath, value reflect.Value) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.SetReflect(jsonPath, value)
+}
+
+// SetCompiled is Object.SetCompiled with a write lock.
+func (n *SyncObject) SetCompiled(compiledPath *path.CompiledPath, value any) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.SetCompiled(compiledPath, value)
+}
+
+// Insert is Object.Insert with a write lock.
+func (n *SyncObject) Insert(jsonPath path.JSONPath, value any) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Insert(jsonPath, value)
+}
+
+// InsertCompiled is Object.InsertCompiled with a write lock.
+func (n *SyncObject) InsertCompiled(compiledPath *path.CompiledPath, value any) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.InsertCompiled(compiledPath, value)
+}
+
+// Append is Object.Append with a write lock.
+func (n *SyncObject) Append(jsonPath path.JSONPath, values ...any) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Append(jsonPath, values...)
+}
+
+// AppendCompiled is Object.AppendCompiled with a write lock.
+func (n *SyncObject) AppendCompiled(compiledPath *path.CompiledPath, values ...any) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.AppendCompiled(compiledPath, values...)
+}
+
+// Delete is Object.Delete with a write lock.
+func (n *SyncObject) Delete(jsonPath path.JSONPath) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Delete(jsonPath)
+}
+
+// DeleteCompiled is Object.DeleteCompiled with a write lock.
+func (n *SyncObject) DeleteCompiled(compiledPath *path.CompiledPath) (uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.DeleteCompiled(compiledPath)
+}
+
+// Update is Object.Update with a write lock.
+func (n *SyncObject) Update(jsonPath path.JSONPath, updateValue UpdateValueInObject) (uint64, uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Update(jsonPath, updateValue)
+}
+
+// UpdateCompiled is Object.UpdateCompiled with a write lock.
+func (n *SyncObject) UpdateCompiled(compiledPath *path.CompiledPath, updateValue UpdateValueInObject) (uint64, uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.UpdateCompiled(compiledPath, updateValue)
+}
+
+// DeleteWhere is Object.DeleteWhere with a write lock.
+func (n *SyncObject) DeleteWhere(jsonPath path.JSONPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.DeleteWhere(jsonPath, valueMatches)
+}
+
+// DeleteWhereCompiled is Object.DeleteWhereCompiled with a write lock.
+func (n *SyncObject) DeleteWhereCompiled(compiledPath *path.CompiledPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.DeleteWhereCompiled(compiledPath, valueMatches)
+}
+
+// RetainWhere is Object.RetainWhere with a write lock.
+func (n *SyncObject) RetainWhere(jsonPath path.JSONPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.RetainWhere(jsonPath, valueMatches)
+}
+
+// RetainWhereCompiled is Object.RetainWhereCompiled with a write lock.
+func (n *SyncObject) RetainWhereCompiled(compiledPath *path.CompiledPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.RetainWhereCompiled(compiledPath, valueMatches)
+}
+
+// ApplyPatch is Object.ApplyPatch with a write lock.
+func (n *SyncObject) ApplyPatch(patch Patch) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.ApplyPatch(patch)
+}
+
+// MergePatch is Object.MergePatch with a write lock.
+func (n *SyncObject) MergePatch(patch any) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.MergePatch(patch)
+}
+
+// Batch is Object.Batch with a write lock.
+func (n *SyncObject) Batch(batch func(tx *Tx) error) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Batch(batch)
+}
+
+// Snapshot is Object.Snapshot with a read lock.
+func (n *SyncObject) Snapshot() *Snapshot {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.Snapshot()
+}
+
+// Restore is Object.Restore with a write lock.
+func (n *SyncObject) Restore(snapshot *Snapshot) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.object.Restore(snapshot)
+}
+
+// Undo is Object.Undo with a write lock.
+func (n *SyncObject) Undo() bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.object.Undo()
+}
+
+// Read calls read with the wrapped Object while holding a read lock. read must not modify the Object.
+func (n *SyncObject) Read(read func(obj *Object)) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	read(n.object)
+}
+
+// Write calls write with the wrapped Object while holding a write lock.
+func (n *SyncObject) Write(write func(obj *Object)) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	write(n.object)
+}
+
+// GetSourceInterface is Object.GetSourceInterface with a read lock.
+func (n *SyncObject) GetSourceInterface() any {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.object.GetSourceInterface()
+}
+
+// SetSourceInterface is Object.SetSourceInterface with a write lock.
+func (n *SyncObject) SetSourceInterface(value any) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.object.SetSourceInterface(value)
+}
+
+// NewSyncObject returns a SyncObject that wraps object. object must not be used directly afterward.
+func NewSyncObject(object *Object) *SyncObject {
+	return &SyncObject{object: object}
+}
@@ -0,0 +1,196 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+// The tests in this file are meant to be run with the race detector i.e., `go test -race ./object/...`.
+
+func concurrencyTestSource() map[string]any {
+	items := make([]any, 0)
+	for i := 0; i < 10; i++ {
+		items = append(items, map[string]any{"id": i, "name": fmt.Sprintf("item-%d", i)})
+	}
+	return map[string]any{"items": items}
+}
+
+func TestObject_ConcurrentReads(t *testing.T) {
+	obj := NewObject().WithSourceInterface(concurrencyTestSource())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				valueFound, noOfResults, err := obj.Get(path.JSONPath(fmt.Sprintf("$.items[%d].name", i)))
+				if noOfResults != 1 || err != nil || valueFound != fmt.Sprintf("item-%d", i) {
+					t.Error("i=", i, "\n", "got=", valueFound, "ok=", noOfResults, "err=", err)
+					return
+				}
+
+				results, err := obj.GetAll(path.JSONPath(fmt.Sprintf("$.items[?@.id >= %d].id", i)))
+				if err != nil || len(results) != 10-i {
+					t.Error("i=", i, "\n", "expected GetAll results=", 10-i, "got=", len(results), "err=", err)
+					return
+				}
+
+				noOfForEach := 0
+				obj.ForEach("$..name", func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+					noOfForEach++
+					return false
+				})
+				if noOfForEach != 10 {
+					t.Error("i=", i, "\n", "expected ForEach results=10, got=", noOfForEach)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestObject_NestedOperations(t *testing.T) {
+	obj := NewObject().WithSourceInterface(concurrencyTestSource())
+
+	noOfForEach := 0
+	obj.ForEach("$.items[*].id", func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+		noOfForEach++
+		valueFound, noOfResults, err := obj.Get(path.JSONPath(fmt.Sprintf("$.items[%v].name", value.Interface())))
+		if noOfResults != 1 || err != nil || valueFound != fmt.Sprintf("item-%v", value.Interface()) {
+			t.Error("path=", jsonPath.NormalizedString(), "\n", "got=", valueFound, "ok=", noOfResults, "err=", err)
+		}
+		return false
+	})
+	if noOfForEach != 10 {
+		t.Error("expected outer ForEach to visit 10 values, got=", noOfForEach)
+	}
+
+	// results of a failed operation must not leak into the next one.
+	if _, noOfResults, err := obj.Get("$.items[20]"); noOfResults != 0 || err == nil {
+		t.Error("expected no result and an error, got ok=", noOfResults, "err=", err)
+	}
+	if _, noOfResults, err := obj.Get("$.items[0].id"); noOfResults != 1 || err != nil {
+		t.Error("expected one result and no error, got ok=", noOfResults, "err=", err)
+	}
+}
+
+func TestObject_SharedConfiguration(t *testing.T) {
+	addressSchema := AddressSchema()
+	converter := schema.NewConversion()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			obj := NewObject().WithSchema(addressSchema).WithDefaultConverter(converter).WithSourceInterface(nil)
+			city := fmt.Sprintf("City-%d", i)
+			if noOfResults, err := obj.Set("$.City", city); noOfResults != 1 || err != nil {
+				t.Error("i=", i, "\n", "expected Set to succeed, got ok=", noOfResults, "err=", err)
+				return
+			}
+			if address, ok := obj.GetSourceInterface().(Address); !ok || address.City != city {
+				t.Error("i=", i, "\n", "got source=", core.JsonStringifyMust(obj.GetSourceInterface()))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestSyncObject_Concurrent(t *testing.T) {
+	syncObject := NewSyncObject(NewObject().WithSourceInterface(map[string]any{}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := syncObject.Set(path.JSONPath(fmt.Sprintf("$.writer%d.count", i)), j); err != nil {
+					t.Error("i=", i, "\n", "expected Set to succeed, got err=", err)
+					return
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				_, _, _ = syncObject.Get("$..count")
+				_, _ = syncObject.GetAll("$.*.count")
+				syncObject.ForEach("$.*", func(jsonPath path.RecursiveDescentSegment, value reflect.Value) bool {
+					return false
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	syncObject.Read(func(obj *Object) {
+		results, err := obj.GetAll("$.*.count")
+		if err != nil || len(results) != 10 {
+			t.Error("expected 10 counts, got=", len(results), "err=", err)
+		}
+		for _, result := range results {
+			if result.Value.Interface() != 19 {
+				t.Error("path=", result.Path.NormalizedString(), "\n", "expected=19, got=", result.Value.Interface())
+			}
+		}
+	})
+
+	syncObject.Write(func(obj *Object) {
+		_, _ = obj.Delete("$.writer0")
+		_, _ = obj.Set("$.done", true)
+	})
+	if valueFound, _, _ := syncObject.Get("$.done"); valueFound != true {
+		t.Error("expected $.done to be true, got=", valueFound)
+	}
+	if _, noOfResults, _ := syncObject.Get("$.writer0"); noOfResults != 0 {
+		t.Error("expected $.writer0 to be deleted")
+	}
+}
+
+func TestSyncObject_ConcurrentPatches(t *testing.T) {
+	syncObject := NewSyncObject(NewObject().WithSourceInterface(map[string]any{"items": []any{}}))
+	compiledPath := path.MustCompile("$.items[*]")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			if err := syncObject.ApplyPatch(Patch{{Op: PatchOpAdd, Path: "/items/-", Value: i}}); err != nil {
+				t.Error("i=", i, "\n", "expected ApplyPatch to succeed, got err=", err)
+			}
+			if err := syncObject.MergePatch(map[string]any{fmt.Sprintf("writer%d", i): true}); err != nil {
+				t.Error("i=", i, "\n", "expected MergePatch to succeed, got err=", err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for range syncObject.All("$.items[*]") {
+			}
+			_, _ = syncObject.GetAllCompiled(compiledPath)
+			_, _ = syncObject.Pick("$.items")
+		}()
+	}
+	wg.Wait()
+
+	noOfItems := 0
+	for range syncObject.AllCompiled(compiledPath) {
+		noOfItems++
+	}
+	if noOfItems != 10 {
+		t.Error("expected 10 items, got=", noOfItems)
+	}
+	if results, err := syncObject.GetAll("$.*"); err != nil || len(results) != 11 {
+		t.Error("expected items and 10 writers, got=", len(results), "err=", err)
+	}
+}
@@ -0,0 +1,170 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+	"github.com/rogonion/go-json/schema"
+)
+
+/*
+traversal holds the state of a single Get, Set, Delete, or ForEach operation.
+
+A new traversal is created for every operation so that the results of one operation are never mixed up with those of another
+even when they run concurrently on the same Object.
+*/
+type traversal struct {
+	// Value the traversal starts from. It is the current value `@` in the traversal of a relative filter query.
+	source reflect.Value
+
+	// Root of the object being traversed. Used by filter queries that start with `$` including those nested in relative filter queries.
+	root reflect.Value
+
+	// Copied from Object.schema.
+	schema schema.Schema
+
+	// Copied from Object.defaultConverter.
+	defaultConverter schema.DefaultConverter
+
+	// Copied from Object.fieldNameStrategy.
+	fieldNameStrategy *core.FieldNameStrategy
+
+	recursiveDescentSegments path.RecursiveDescentSegments
+
+	// Value to set in source by Set.
+	valueToSet reflect.Value
+
+	// If true, Set inserts valueToSet at the last index in the path shifting the elements after it instead of replacing the element. Used by Insert.
+	insert bool
+
+	// Copied from Object.copyOnWrite. If true, Set and Delete modify shallow copies of the collections along the path.
+	copyOnWrite bool
+
+	// Used by ForEach.
+	ifValueFoundInObject IfValueFoundInObject
+
+	// Made by Get, Set and Delete.
+	noOfResults uint64
+
+	// Last error encountered when processing the source especially for the recursive descent pattern or union pattern in path.JSONPath.
+	lastError error
+}
+
+// newTraversal returns a traversal of `Object.source` using recursiveDescentSegments as the guide.
+func (n *Object) newTraversal(recursiveDescentSegments path.RecursiveDescentSegments) *traversal {
+	return &traversal{
+		source:                   n.source,
+		root:                     n.source,
+		schema:                   n.schema,
+		defaultConverter:         n.defaultConverter,
+		fieldNameStrategy:        n.fieldNameStrategy,
+		copyOnWrite:              n.copyOnWrite,
+		recursiveDescentSegments: recursiveDescentSegments,
+	}
+}
+
+// result returns the number of results and the last error encountered if no results were found.
+func (n *traversal) result() (uint64, error) {
+	if n.noOfResults > 0 {
+		return n.noOfResults, nil
+	}
+	return n.noOfResults, n.lastError
+}
+
+/*
+structField returns the value of the field of structValue that name resolves to using traversal.fieldNameStrategy along with the name of the field in a path.
+
+The name returned may differ from name e.g., if the strategy ignores case. Returns false if there is no such field.
+*/
+func (n *traversal) structField(structValue reflect.Value, name string) (reflect.Value, string, bool) {
+	structField, ok := n.fieldNameStrategy.Field(structValue.Type(), name)
+	if !ok {
+		return reflect.Value{}, "", false
+	}
+	return structField.Value(structValue), structField.Name, true
+}
+
+// settableStructField is like structField but allocates nil pointers to embedded structs that the field is promoted through.
+func (n *traversal) settableStructField(structValue reflect.Value, name string) (reflect.Value, bool) {
+	structField, ok := n.fieldNameStrategy.Field(structValue.Type(), name)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return structField.SettableValue(structValue), true
+}
+
+// invalidStructFieldMessage returns the error message for when recursiveSegment cannot be resolved to a field of structValue.
+func (n *traversal) invalidStructFieldMessage(structValue reflect.Value, recursiveSegment *path.CollectionMemberSegment) string {
+	if n.fieldNameStrategy.IsAmbiguous(structValue.Type(), recursiveSegment.Key) {
+		return fmt.Sprintf("key %s is ambiguous in struct", recursiveSegment)
+	}
+	return fmt.Sprintf("key %s is not valid for struct", recursiveSegment)
+}
+
+/*
+settableCopy returns value if it can be set, otherwise a settable copy of it.
+
+Values that cannot be set such as struct values inside maps are modified through the copy which the caller assigns back e.g., using reflect.Value.SetMapIndex.
+*/
+func settableCopy(value reflect.Value) reflect.Value {
+	if value.CanSet() {
+		return value
+	}
+	settableValue := reflect.New(value.Type()).Elem()
+	settableValue.Set(value)
+	return settableValue
+}
+
+/*
+shallowCopy returns a settable copy of value that shares its members with value.
+
+Maps and slices are copied to new ones and pointers to a new pointer to a copy of the value pointed to. Other values such as structs and arrays are copied as they are.
+*/
+func shallowCopy(value reflect.Value) reflect.Value {
+	if core.IsNilOrInvalid(value) {
+		return value
+	}
+
+	switch value.Kind() {
+	case reflect.Interface:
+		return value
+	case reflect.Pointer:
+		valueCopy := reflect.New(value.Type().Elem())
+		valueCopy.Elem().Set(value.Elem())
+		return valueCopy
+	case reflect.Map:
+		valueCopy := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			valueCopy.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return valueCopy
+	case reflect.Slice:
+		valueCopy := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		reflect.Copy(valueCopy, value)
+		return valueCopy
+	default:
+		valueCopy := reflect.New(value.Type()).Elem()
+		valueCopy.Set(value)
+		return valueCopy
+	}
+}
+
+/*
+keepIfUnmodified sets modifiedValue to originalValue if no results were made since noOfResults was recorded.
+
+Deferred by the recursive Set and Delete functions when traversal.copyOnWrite is true so that the copies of collections that were not modified are discarded
+and the original is shared instead.
+*/
+func (n *traversal) keepIfUnmodified(originalValue reflect.Value, noOfResults uint64, modifiedValue *reflect.Value) {
+	if n.noOfResults == noOfResults {
+		*modifiedValue = originalValue
+	}
+}
+
+// schemaAtPath returns the schema in traversal.schema at currentPath resolving struct fields with traversal.fieldNameStrategy.
+func (n *traversal) schemaAtPath(currentPath path.RecursiveDescentSegment) (*schema.DynamicSchemaNode, error) {
+	return schema.GetSchemaAtPathWithFieldNameStrategy(currentPath, n.schema, n.fieldNameStrategy)
+}
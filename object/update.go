@@ -0,0 +1,181 @@
+package object
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/path"
+)
+
+/*
+UpdateValueInObject is called by Object.Update for each value found at path.JSONPath.
+
+Parameters:
+  - jsonPath - Concrete path where value was found made up of the root, keys, and indexes. Copy it if it is kept after the callback returns.
+  - value - value found in Go form or nil.
+
+Returns the new value, false to delete the value instead, and an error to stop Object.Update without modifying `Object.source`.
+*/
+type UpdateValueInObject func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error)
+
+/*
+ValueMatches is called by Object.DeleteWhere and Object.RetainWhere for each value found at path.JSONPath.
+
+Returns true if value matches and an error to stop without modifying `Object.source`.
+*/
+type ValueMatches func(jsonPath path.RecursiveDescentSegment, value any) (bool, error)
+
+/*
+Update replaces each value in `Object.source` found at jsonPath with the value returned by updateValue e.g., to normalize emails or bump versions.
+
+Unlike modifying the reflect.Value passed to ForEach, which cannot be set for map values or structs inside maps, new values are written back using Object.Set
+hence they are converted to the type at the path using `Object.defaultConverter` and `Object.schema`. Values returned by updateValue that are deeply equal
+to the old value are not written. If updateValue returns false, the value is removed using Object.Delete.
+
+updateValue is called for every value found before `Object.source` is modified. Like ApplyPatch, the new values are then set in a deep copy of `Object.source`
+which only replaces `Object.source` if every value is set or deleted. If updateValue returns an error, or a value cannot be set or deleted, `Object.source` is left unchanged.
+
+Parameters:
+  - jsonPath
+  - updateValue - Called with each value found.
+
+Returns the number of values found, the number of values updated or deleted, and the last error encountered.
+*/
+func (n *Object) Update(jsonPath path.JSONPath, updateValue UpdateValueInObject) (uint64, uint64, error) {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n.update(recursiveDescentSegments, updateValue)
+}
+
+// UpdateCompiled is like Update but uses a path that has already been compiled with path.Compile.
+func (n *Object) UpdateCompiled(compiledPath *path.CompiledPath, updateValue UpdateValueInObject) (uint64, uint64, error) {
+	return n.update(compiledPath.Segments(), updateValue)
+}
+
+/*
+DeleteWhere removes each value in `Object.source` found at jsonPath for which valueMatches returns true.
+
+It works like Update hence `Object.source` is left unchanged if valueMatches returns an error.
+
+Returns the number of values found, the number of values deleted, and the last error encountered.
+*/
+func (n *Object) DeleteWhere(jsonPath path.JSONPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n.update(recursiveDescentSegments, deleteIfValueMatches(valueMatches, true))
+}
+
+// DeleteWhereCompiled is like DeleteWhere but uses a path that has already been compiled with path.Compile.
+func (n *Object) DeleteWhereCompiled(compiledPath *path.CompiledPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	return n.update(compiledPath.Segments(), deleteIfValueMatches(valueMatches, true))
+}
+
+/*
+RetainWhere removes each value in `Object.source` found at jsonPath for which valueMatches returns false e.g., `$.items[*]` to only keep some items.
+
+It works like Update hence `Object.source` is left unchanged if valueMatches returns an error.
+
+Returns the number of values found, the number of values deleted, and the last error encountered.
+*/
+func (n *Object) RetainWhere(jsonPath path.JSONPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	recursiveDescentSegments, err := parsePath(jsonPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n.update(recursiveDescentSegments, deleteIfValueMatches(valueMatches, false))
+}
+
+// RetainWhereCompiled is like RetainWhere but uses a path that has already been compiled with path.Compile.
+func (n *Object) RetainWhereCompiled(compiledPath *path.CompiledPath, valueMatches ValueMatches) (uint64, uint64, error) {
+	return n.update(compiledPath.Segments(), deleteIfValueMatches(valueMatches, false))
+}
+
+// deleteIfValueMatches returns an UpdateValueInObject that keeps each value unchanged unless the result of valueMatches is equal to deleteIf.
+func deleteIfValueMatches(valueMatches ValueMatches, deleteIf bool) UpdateValueInObject {
+	return func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+		matches, err := valueMatches(jsonPath, value)
+		if err != nil {
+			return value, true, err
+		}
+		return value, matches != deleteIf, nil
+	}
+}
+
+/*
+update is the underlying implementation of Update that works with an already parsed path.
+
+New values are set in the order they were found. Values are then deleted once each, descendants and higher indexes first, so that removing an element
+from a slice does not shift the indexes of the other elements to delete. Modifications are applied to a copy of `Object.source` which replaces
+`Object.source` only if all of them succeed.
+*/
+func (n *Object) update(recursiveDescentSegments path.RecursiveDescentSegments, updateValue UpdateValueInObject) (uint64, uint64, error) {
+	const FunctionName = "Update"
+
+	type valueToSet struct {
+		path  path.RecursiveDescentSegment
+		value any
+	}
+
+	valuesToSet := make([]valueToSet, 0)
+	pathsToDelete := make([]path.RecursiveDescentSegment, 0)
+
+	var noOfResults uint64
+	var lastError error
+	n.forEach(recursiveDescentSegments, func(currentPath path.RecursiveDescentSegment, value reflect.Value) bool {
+		noOfResults++
+
+		oldValue := valueFoundInterface(value)
+		newValue, keep, err := updateValue(currentPath, oldValue)
+		if err != nil {
+			lastError = NewError().WithFunctionName(FunctionName).WithMessage("updateValue failed").WithNestedError(err).WithData(core.JsonObject{"Path": currentPath.String()})
+			return true
+		}
+
+		if !keep {
+			pathsToDelete = append(pathsToDelete, slices.Clone(currentPath))
+		} else if !reflect.DeepEqual(oldValue, newValue) {
+			valuesToSet = append(valuesToSet, valueToSet{path: slices.Clone(currentPath), value: newValue})
+		}
+		return false
+	})
+	if lastError != nil {
+		return noOfResults, 0, lastError
+	}
+
+	// descendants and higher indexes are deleted first so that the remaining paths stay valid.
+	slices.SortFunc(pathsToDelete, compareConcretePaths)
+	pathsToDelete = slices.CompactFunc(pathsToDelete, func(a, b path.RecursiveDescentSegment) bool {
+		return compareConcretePaths(a, b) == 0
+	})
+	slices.Reverse(pathsToDelete)
+
+	if len(valuesToSet) == 0 && len(pathsToDelete) == 0 {
+		return noOfResults, 0, nil
+	}
+
+	workingObject := n.workingCopy()
+
+	var noOfModifications uint64
+	for _, value := range valuesToSet {
+		if ok, err := workingObject.set(path.RecursiveDescentSegments{value.path}, reflect.ValueOf(value.value)); ok == 0 {
+			return noOfResults, 0, NewError().WithFunctionName(FunctionName).WithMessage("value not set").WithNestedError(errors.Join(ErrValueAtPathSegmentInvalidError, err)).WithData(core.JsonObject{"Path": value.path.String(), "Value": value.value})
+		}
+		noOfModifications++
+	}
+
+	for _, pathToDelete := range pathsToDelete {
+		if ok, err := workingObject.delete(path.RecursiveDescentSegments{pathToDelete}); ok == 0 {
+			return noOfResults, 0, NewError().WithFunctionName(FunctionName).WithMessage("value not deleted").WithNestedError(errors.Join(ErrValueAtPathSegmentInvalidError, err)).WithData(core.JsonObject{"Path": pathToDelete.String()})
+		}
+		noOfModifications++
+	}
+
+	n.replaceSource(workingObject.source)
+	return noOfResults, noOfModifications, nil
+}
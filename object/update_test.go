@@ -0,0 +1,343 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
+)
+
+func TestObject_Update(t *testing.T) {
+	for testData := range UpdateTestData {
+		obj := NewObject().WithSourceInterface(testData.Root)
+
+		noOfResults, noOfModifications, err := obj.Update(testData.Path, testData.UpdateValue)
+		if noOfResults != testData.ExpectedNoOfResults || noOfModifications != testData.ExpectedNoOfModifications {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected noOfResults=", testData.ExpectedNoOfResults, "noOfModifications=", testData.ExpectedNoOfModifications, "\n",
+				"got noOfResults=", noOfResults, "noOfModifications=", noOfModifications, "\n",
+				"path=", testData.Path,
+			)
+		}
+
+		if (err != nil) != testData.ExpectedErr {
+			t.Error(
+				testData.TestTitle, "\n",
+				"expected err=", testData.ExpectedErr, "got=", err,
+			)
+		}
+
+		if !reflect.DeepEqual(obj.GetSourceInterface(), testData.ExpectedValue) {
+			t.Error(
+				testData.TestTitle, "\n",
+				"source not equal to testData.ExpectedValue\n",
+				"source=", core.JsonStringifyMust(obj.GetSourceInterface()), "\n",
+				"testData.ExpectedValue=", core.JsonStringifyMust(testData.ExpectedValue),
+			)
+		}
+	}
+}
+
+type UpdateData struct {
+	internal.TestData
+	Root                      any
+	Path                      path.JSONPath
+	UpdateValue               UpdateValueInObject
+	ExpectedNoOfResults       uint64
+	ExpectedNoOfModifications uint64
+	ExpectedErr               bool
+	ExpectedValue             any
+}
+
+func UpdateTestData(yield func(data *UpdateData) bool) {
+	lowerCase := func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+		return strings.ToLower(value.(string)), true, nil
+	}
+	deleteEven := func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+		return value, value.(int)%2 != 0, nil
+	}
+
+	testCaseIndex := 1
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Normalize map values in a slice", testCaseIndex),
+			},
+			Root: map[string]any{
+				"users": []any{
+					map[string]any{"email": "Alice@Example.com"},
+					map[string]any{"email": "bob@example.com"},
+				},
+			},
+			Path:                      "$.users[*].email",
+			UpdateValue:               lowerCase,
+			ExpectedNoOfResults:       2,
+			ExpectedNoOfModifications: 1,
+			ExpectedValue: map[string]any{
+				"users": []any{
+					map[string]any{"email": "alice@example.com"},
+					map[string]any{"email": "bob@example.com"},
+				},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Bump struct field inside a map", testCaseIndex),
+			},
+			Root: map[string]UserProfile{
+				"alice": {Name: "Alice", Age: 30},
+				"bob":   {Name: "Bob", Age: 40},
+			},
+			Path: "$..Age",
+			UpdateValue: func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+				return value.(int) + 1, true, nil
+			},
+			ExpectedNoOfResults:       2,
+			ExpectedNoOfModifications: 2,
+			ExpectedValue: map[string]UserProfile{
+				"alice": {Name: "Alice", Age: 31},
+				"bob":   {Name: "Bob", Age: 41},
+			},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: New value converted to type at path", testCaseIndex),
+			},
+			Root: &UserProfile{Name: "Alice", Age: 30},
+			Path: "$.Age",
+			UpdateValue: func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+				return "31", true, nil
+			},
+			ExpectedNoOfResults:       1,
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             &UserProfile{Name: "Alice", Age: 31},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete values with keep false", testCaseIndex),
+			},
+			Root: []any{1, 2, 3, 4, 5},
+			Path: "$[*]",
+			UpdateValue: func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+				if value.(int)%2 == 0 {
+					return nil, false, nil
+				}
+				return value.(int) * 10, true, nil
+			},
+			ExpectedNoOfResults:       5,
+			ExpectedNoOfModifications: 5,
+			ExpectedValue:             []any{10, 30, 50},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Error leaves source unchanged", testCaseIndex),
+			},
+			Root: []any{"A", 1, "B"},
+			Path: "$[*]",
+			UpdateValue: func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+				s, ok := value.(string)
+				if !ok {
+					return nil, false, errors.New("not a string")
+				}
+				return strings.ToLower(s), true, nil
+			},
+			ExpectedNoOfResults: 2,
+			ExpectedErr:         true,
+			ExpectedValue:       []any{"A", 1, "B"},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete with union in descending order", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": []any{0, 1, 2, 3, 4}},
+			Path:                      "$.a[2,0]",
+			UpdateValue:               deleteEven,
+			ExpectedNoOfResults:       2,
+			ExpectedNoOfModifications: 2,
+			ExpectedValue:             map[string]any{"a": []any{1, 3, 4}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete duplicate paths once", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": []any{0, 1, 2, 3, 4}},
+			Path:                      "$.a[0,0]",
+			UpdateValue:               deleteEven,
+			ExpectedNoOfResults:       2,
+			ExpectedNoOfModifications: 1,
+			ExpectedValue:             map[string]any{"a": []any{1, 2, 3, 4}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete with negative step slice", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": []any{0, 1, 2, 3, 4}},
+			Path:                      "$.a[::-1]",
+			UpdateValue:               deleteEven,
+			ExpectedNoOfResults:       5,
+			ExpectedNoOfModifications: 3,
+			ExpectedValue:             map[string]any{"a": []any{1, 3}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Delete with negative index union", testCaseIndex),
+			},
+			Root:                      map[string]any{"a": []any{0, 1, 2, 3, 4}},
+			Path:                      "$.a[-1,0]",
+			UpdateValue:               deleteEven,
+			ExpectedNoOfResults:       2,
+			ExpectedNoOfModifications: 2,
+			ExpectedValue:             map[string]any{"a": []any{1, 2, 3}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Failed delete leaves source unchanged", testCaseIndex),
+			},
+			Root: map[string]any{"a": [2]int{1, 2}, "b": []any{1, 2}},
+			Path: "$.*[0]",
+			UpdateValue: func(jsonPath path.RecursiveDescentSegment, value any) (any, bool, error) {
+				return value, false, nil
+			},
+			ExpectedNoOfResults: 2,
+			ExpectedErr:         true,
+			ExpectedValue:       map[string]any{"a": [2]int{1, 2}, "b": []any{1, 2}},
+		},
+	) {
+		return
+	}
+
+	testCaseIndex++
+	if !yield(
+		&UpdateData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: No value found", testCaseIndex),
+			},
+			Root:          map[string]any{"users": []any{}},
+			Path:          "$.users[*].email",
+			UpdateValue:   lowerCase,
+			ExpectedValue: map[string]any{"users": []any{}},
+		},
+	) {
+		return
+	}
+}
+
+func TestObject_DeleteWhere(t *testing.T) {
+	source := map[string]any{
+		"orders": []any{
+			map[string]any{"id": 1, "status": "cancelled"},
+			map[string]any{"id": 2, "status": "paid"},
+			map[string]any{"id": 3, "status": "cancelled"},
+		},
+		"tags": map[string]any{"a": "", "b": "x"},
+	}
+	obj := NewObject().WithSourceInterface(source)
+
+	isCancelled := func(jsonPath path.RecursiveDescentSegment, value any) (bool, error) {
+		return value.(map[string]any)["status"] == "cancelled", nil
+	}
+	if noOfResults, noOfModifications, err := obj.DeleteWhere("$.orders[*]", isCancelled); noOfResults != 3 || noOfModifications != 2 || err != nil {
+		t.Error("expected 3 results and 2 deletions, got=", noOfResults, noOfModifications, "err=", err)
+	}
+	if noOfResults, noOfModifications, err := obj.DeleteWhere("$.tags.*", func(jsonPath path.RecursiveDescentSegment, value any) (bool, error) {
+		return value == "", nil
+	}); noOfResults != 2 || noOfModifications != 1 || err != nil {
+		t.Error("expected 2 results and 1 deletion, got=", noOfResults, noOfModifications, "err=", err)
+	}
+
+	expected := map[string]any{
+		"orders": []any{map[string]any{"id": 2, "status": "paid"}},
+		"tags":   map[string]any{"b": "x"},
+	}
+	if !reflect.DeepEqual(obj.GetSourceInterface(), expected) {
+		t.Error("expected=", core.JsonStringifyMust(expected), "got=", core.JsonStringifyMust(obj.GetSourceInterface()))
+	}
+
+	errPredicate := errors.New("predicate failed")
+	if _, noOfModifications, err := obj.DeleteWhere("$.orders[*]", func(jsonPath path.RecursiveDescentSegment, value any) (bool, error) {
+		return true, errPredicate
+	}); noOfModifications != 0 || !errors.Is(err, errPredicate) {
+		t.Error("expected error from predicate and no deletions, got=", noOfModifications, "err=", err)
+	}
+}
+
+func TestObject_RetainWhere(t *testing.T) {
+	order := &ComplexData{Items: []struct {
+		Name  string
+		Value int
+	}{{"a", 1}, {"b", 20}, {"c", 30}, {"d", 4}}}
+	obj := NewObject().WithSourceInterface(order)
+
+	noOfResults, noOfModifications, err := obj.RetainWhere("$.Items[*]", func(jsonPath path.RecursiveDescentSegment, value any) (bool, error) {
+		return reflect.ValueOf(value).FieldByName("Value").Int() >= 10, nil
+	})
+	if noOfResults != 4 || noOfModifications != 2 || err != nil {
+		t.Error("expected 4 results and 2 deletions, got=", noOfResults, noOfModifications, "err=", err)
+	}
+	if retained := obj.GetSourceInterface().(*ComplexData); len(retained.Items) != 2 || retained.Items[0].Name != "b" || retained.Items[1].Name != "c" {
+		t.Error("expected items b and c to be retained, got=", retained.Items)
+	}
+	if len(order.Items) != 4 {
+		t.Error("expected the value passed to WithSourceInterface to be unchanged, got=", order.Items)
+	}
+}
@@ -0,0 +1,408 @@
+package path
+
+/*
+pathSelector is a selector in a path together with whether it is preceded by the recursive descent operator (`..`).
+
+Paths are compared as a flat list of pathSelector starting after the root `$`.
+*/
+type pathSelector struct {
+	segment    *CollectionMemberSegment
+	descendant bool
+}
+
+// selectors returns the selectors of the path after the root `$`.
+func (n RecursiveDescentSegments) selectors() []pathSelector {
+	selectors := make([]pathSelector, 0)
+	for i, recursiveDescentSegment := range n {
+		descendant := i > 0
+		for _, collectionMemberSegment := range recursiveDescentSegment {
+			if collectionMemberSegment == nil || collectionMemberSegment.IsKeyRoot {
+				continue
+			}
+			selectors = append(selectors, pathSelector{segment: canonicalSelector(collectionMemberSegment), descendant: descendant})
+			descendant = false
+		}
+	}
+	return selectors
+}
+
+// recursiveDescentSegmentsFromSelectors is the reverse of RecursiveDescentSegments.selectors.
+func recursiveDescentSegmentsFromSelectors(selectors []pathSelector) RecursiveDescentSegments {
+	recursiveDescentSegments := RecursiveDescentSegments{{{Key: JsonpathKeyRoot, IsKeyRoot: true, ExpectLinear: true, ExpectAssociative: true}}}
+	for _, selector := range selectors {
+		if selector.descendant {
+			recursiveDescentSegments = append(recursiveDescentSegments, RecursiveDescentSegment{selector.segment})
+			continue
+		}
+		last := len(recursiveDescentSegments) - 1
+		recursiveDescentSegments[last] = append(recursiveDescentSegments[last], selector.segment)
+	}
+	return recursiveDescentSegments
+}
+
+// canonicalSelector returns the member of a union with a single member e.g., `['a']` is the same as `.a`.
+func canonicalSelector(collectionMemberSegment *CollectionMemberSegment) *CollectionMemberSegment {
+	if len(collectionMemberSegment.UnionSelector) == 1 && collectionMemberSegment.UnionSelector[0] != nil && !collectionMemberSegment.IsKey && !collectionMemberSegment.IsIndex {
+		member := collectionMemberSegment.UnionSelector[0]
+		if member.IsKey {
+			return &CollectionMemberSegment{Key: member.Key, IsKey: true, ExpectAssociative: true}
+		}
+		if member.IsIndex {
+			return &CollectionMemberSegment{Index: member.Index, IsIndex: true, ExpectLinear: true}
+		}
+	}
+	return collectionMemberSegment
+}
+
+/*
+Equal returns true if n and other select the same nodes in any document because they are written the same way after normalization.
+
+The following are considered equal:
+  - Dot and bracket notation e.g., `$.a` and `$['a']`.
+  - Wildcards e.g., `$.*` and `$[*]`, and slices that select every element e.g., `$[::1]` and `$[0:]`, which are the same as `$[:]` that is parsed as a wildcard.
+  - An index in a JSONPointer and in a JSONPath e.g., `/a/0` and `$.a[0]`.
+  - A union with a single member and the member itself e.g., Builder.UnionKeys("a") and Builder.Key("a").
+  - Slices with default values e.g., `$[0:5:1]` and `$[:5]`.
+  - Filters that are written the same way after parsing e.g., `$[?@.a==1]` and `$[?@.a == 1]`.
+*/
+func (n RecursiveDescentSegments) Equal(other RecursiveDescentSegments) bool {
+	selectors, otherSelectors := n.selectors(), other.selectors()
+	if len(selectors) != len(otherSelectors) {
+		return false
+	}
+	return selectorsArePrefix(selectors, otherSelectors)
+}
+
+/*
+IsPrefixOf returns true if other starts with n e.g., `$.a.b` is a prefix of `$.a.b[2]` and `$.a..c` is a prefix of `$.a..c.d`.
+
+In other words, every node selected by other is a node selected by n or one of its descendants. A path is a prefix of itself.
+*/
+func (n RecursiveDescentSegments) IsPrefixOf(other RecursiveDescentSegments) bool {
+	return selectorsArePrefix(n.selectors(), other.selectors())
+}
+
+func selectorsArePrefix(selectors []pathSelector, otherSelectors []pathSelector) bool {
+	if len(selectors) > len(otherSelectors) {
+		return false
+	}
+	for i := range selectors {
+		if selectors[i].descendant != otherSelectors[i].descendant || !selectorsEqual(selectors[i].segment, otherSelectors[i].segment) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Parent returns the path without its last selector e.g., the parent of `$.a.b[2]` is `$.a.b`.
+
+For a path that ends with a recursive descent e.g., `$.a..c`, the parent is `$.a` which is the closest ancestor shared by every node selected.
+Returns false if n is the root `$`.
+*/
+func (n RecursiveDescentSegments) Parent() (RecursiveDescentSegments, bool) {
+	selectors := n.selectors()
+	if len(selectors) == 0 {
+		return nil, false
+	}
+	return recursiveDescentSegmentsFromSelectors(selectors[:len(selectors)-1]), true
+}
+
+/*
+Relativize returns the path of other relative to n e.g., `$.a.b` relativizes `$.a.b[2].c` to `$[2].c`.
+
+The relative path starts with the root `$` which stands for the node selected by n. Use Join to get other back.
+Returns false if n is not a prefix of other.
+*/
+func (n RecursiveDescentSegments) Relativize(other RecursiveDescentSegments) (RecursiveDescentSegments, bool) {
+	selectors, otherSelectors := n.selectors(), other.selectors()
+	if !selectorsArePrefix(selectors, otherSelectors) {
+		return nil, false
+	}
+	return recursiveDescentSegmentsFromSelectors(otherSelectors[len(selectors):]), true
+}
+
+/*
+Join appends relative to n e.g., `$.a.b` joined with `$[2].c` is `$.a.b[2].c` and `$.a` joined with `$..c` is `$.a..c`.
+
+The root `$` of relative stands for the node selected by n.
+*/
+func (n RecursiveDescentSegments) Join(relative RecursiveDescentSegments) RecursiveDescentSegments {
+	return recursiveDescentSegmentsFromSelectors(append(n.selectors(), relative.selectors()...))
+}
+
+/*
+Matches returns true if the node at the concrete path would be selected by n in any document that has the node.
+
+concrete must be made up of the root, keys, and non-negative indexes like the paths returned by Object.GetAll or JSONPointer.Parse.
+A member of a JSONPointer that is both a key and an index matches either.
+
+Selectors that depend on the document cannot be matched hence they never match:
+  - Filter selectors e.g., `[?@.price < 10]`.
+  - Negative indexes and slices with a negative start, end, or step as they depend on the length of the collection.
+
+Example:
+
+	JSONPath("$.a..c").Parse().Matches(JSONPath("$.a.b[3].c").Parse()[0]) // true
+*/
+func (n RecursiveDescentSegments) Matches(concrete RecursiveDescentSegment) bool {
+	selectors := n.selectors()
+
+	// states holds the number of selectors that have matched the members of concrete so far. There may be more than one due to recursive descent.
+	states := map[int]bool{0: true}
+	for _, member := range concrete {
+		if member == nil || member.IsKeyRoot {
+			continue
+		}
+		if !member.IsKey && !(member.IsIndex && member.Index >= 0) {
+			return false
+		}
+
+		nextStates := make(map[int]bool)
+		for state := range states {
+			if state == len(selectors) {
+				continue
+			}
+			if selectors[state].descendant {
+				nextStates[state] = true
+			}
+			if selectorMatchesMember(selectors[state].segment, member) {
+				nextStates[state+1] = true
+			}
+		}
+		if len(nextStates) == 0 {
+			return false
+		}
+		states = nextStates
+	}
+
+	return states[len(selectors)]
+}
+
+/*
+Overlaps returns true if there may be a document in which n and other select the same node e.g., `$.a..c` and `$.*.b.c` overlap while `$.a.b` and `$.a.c` do not.
+
+Selectors that depend on the document such as filter selectors and negative indexes are assumed to overlap with any selector that they could select the same member as.
+Hence a false result is certain while a true result means the paths may select the same node.
+*/
+func (n RecursiveDescentSegments) Overlaps(other RecursiveDescentSegments) bool {
+	selectors, otherSelectors := n.selectors(), other.selectors()
+
+	type state struct{ i, j int }
+	visited := map[state]bool{}
+	pending := []state{{0, 0}}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		if current.i == len(selectors) && current.j == len(otherSelectors) {
+			return true
+		}
+		if current.i == len(selectors) || current.j == len(otherSelectors) {
+			continue
+		}
+
+		selector, otherSelector := selectors[current.i], otherSelectors[current.j]
+		if selectorsOverlap(selector.segment, otherSelector.segment) {
+			pending = append(pending, state{current.i + 1, current.j + 1})
+		}
+		// a selector preceded by recursive descent can skip members matched by the other path.
+		if otherSelector.descendant && selectorIsSatisfiable(selector.segment) {
+			pending = append(pending, state{current.i + 1, current.j})
+		}
+		if selector.descendant && selectorIsSatisfiable(otherSelector.segment) {
+			pending = append(pending, state{current.i, current.j + 1})
+		}
+	}
+
+	return false
+}
+
+// selectorMatchesMember returns true if selector certainly selects member which is a key or a non-negative index.
+func selectorMatchesMember(selector *CollectionMemberSegment, member *CollectionMemberSegment) bool {
+	selector = keyOrIndexSelector(selector)
+	switch {
+	case selector.IsKeyIndexAll:
+		return true
+	case selector.FilterSelector != nil:
+		return false
+	case selector.IsKey:
+		return member.IsKey && member.Key == selector.Key
+	case selector.IsIndex:
+		return selector.Index >= 0 && member.IsIndex && member.Index == selector.Index
+	case selector.LinearCollectionSelector != nil:
+		contains, decidable := linearCollectionSelectorContains(selector.LinearCollectionSelector, member.Index)
+		return member.IsIndex && decidable && contains
+	case len(selector.UnionSelector) > 0:
+		for _, unionMember := range selector.UnionSelector {
+			if unionMember != nil && selectorMatchesMember(unionMember, member) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectorsOverlap returns true if selector and otherSelector may select the same member.
+func selectorsOverlap(selector *CollectionMemberSegment, otherSelector *CollectionMemberSegment) bool {
+	selector, otherSelector = keyOrIndexSelector(selector), keyOrIndexSelector(otherSelector)
+	if !selectorIsSatisfiable(selector) || !selectorIsSatisfiable(otherSelector) {
+		return false
+	}
+
+	if len(selector.UnionSelector) > 0 {
+		for _, unionMember := range selector.UnionSelector {
+			if unionMember != nil && selectorsOverlap(unionMember, otherSelector) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(otherSelector.UnionSelector) > 0 {
+		return selectorsOverlap(otherSelector, selector)
+	}
+
+	switch {
+	case selector.IsKeyIndexAll || otherSelector.IsKeyIndexAll || selector.FilterSelector != nil || otherSelector.FilterSelector != nil:
+		return true
+	case selector.IsKey || otherSelector.IsKey:
+		return selector.IsKey && otherSelector.IsKey && selector.Key == otherSelector.Key
+	case selector.IsIndex && otherSelector.IsIndex:
+		// a negative and a non-negative index select the same element in a collection of the right length.
+		return selector.Index == otherSelector.Index || (selector.Index < 0) != (otherSelector.Index < 0)
+	case selector.IsIndex && otherSelector.LinearCollectionSelector != nil:
+		return indexMayBeInLinearCollectionSelector(selector.Index, otherSelector.LinearCollectionSelector)
+	case otherSelector.IsIndex && selector.LinearCollectionSelector != nil:
+		return indexMayBeInLinearCollectionSelector(otherSelector.Index, selector.LinearCollectionSelector)
+	}
+	return true
+}
+
+// keyOrIndexSelector returns a union of the key and the index for a selector from a JSONPointer that is both e.g., `/0` selects `['0',0]`.
+func keyOrIndexSelector(selector *CollectionMemberSegment) *CollectionMemberSegment {
+	if !selector.IsKey || !selector.IsIndex {
+		return selector
+	}
+	return &CollectionMemberSegment{UnionSelector: []*CollectionMemberSegment{{Key: selector.Key, IsKey: true}, {Index: selector.Index, IsIndex: true}}}
+}
+
+// selectorIsSatisfiable returns false if selector can never select a member e.g., an empty union or a slice with a step of 0.
+func selectorIsSatisfiable(selector *CollectionMemberSegment) bool {
+	if selector.LinearCollectionSelector != nil && selector.LinearCollectionSelector.IsStep && selector.LinearCollectionSelector.Step == 0 {
+		return false
+	}
+	if !selector.IsKey && !selector.IsIndex && !selector.IsKeyIndexAll && selector.LinearCollectionSelector == nil && selector.FilterSelector == nil {
+		for _, unionMember := range selector.UnionSelector {
+			if unionMember != nil && selectorIsSatisfiable(unionMember) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func indexMayBeInLinearCollectionSelector(index int, linearCollectionSelector *LinearCollectionSelector) bool {
+	if index < 0 {
+		return true
+	}
+	contains, decidable := linearCollectionSelectorContains(linearCollectionSelector, index)
+	return contains || !decidable
+}
+
+/*
+linearCollectionSelectorContains returns true if the slice selects the non-negative index in every collection that has the index.
+
+decidable is false if the answer depends on the length of the collection i.e., the slice has a negative start, end, or step.
+*/
+func linearCollectionSelectorContains(linearCollectionSelector *LinearCollectionSelector, index int) (contains bool, decidable bool) {
+	start, end, step := 0, -1, 1
+	if linearCollectionSelector.IsStep {
+		step = linearCollectionSelector.Step
+	}
+	if linearCollectionSelector.IsStart {
+		start = linearCollectionSelector.Start
+	}
+	if linearCollectionSelector.IsEnd {
+		end = linearCollectionSelector.End
+	}
+	if step < 0 || start < 0 || (linearCollectionSelector.IsEnd && end < 0) {
+		return false, false
+	}
+	if step == 0 {
+		return false, true
+	}
+	return index >= start && (!linearCollectionSelector.IsEnd || index < end) && (index-start)%step == 0, true
+}
+
+// selectorsEqual returns true if selector and otherSelector are written the same way after normalization.
+func selectorsEqual(selector *CollectionMemberSegment, otherSelector *CollectionMemberSegment) bool {
+	switch {
+	case selector.IsKeyIndexAll || otherSelector.IsKeyIndexAll:
+		return selectsEveryMember(selector) && selectsEveryMember(otherSelector)
+	case selector.IsIndex && otherSelector.IsIndex:
+		// a member of a JSONPointer such as `/0` is both a key and an index.
+		return selector.Index == otherSelector.Index
+	case selector.IsKey || otherSelector.IsKey:
+		return selector.IsKey && otherSelector.IsKey && selector.Key == otherSelector.Key
+	case selector.IsIndex || otherSelector.IsIndex:
+		return selector.IsIndex && otherSelector.IsIndex && selector.Index == otherSelector.Index
+	case selector.IsIndexEnd || otherSelector.IsIndexEnd:
+		return selector.IsIndexEnd && otherSelector.IsIndexEnd
+	case selector.LinearCollectionSelector != nil || otherSelector.LinearCollectionSelector != nil:
+		return selector.LinearCollectionSelector != nil && otherSelector.LinearCollectionSelector != nil &&
+			canonicalLinearCollectionSelector(selector.LinearCollectionSelector) == canonicalLinearCollectionSelector(otherSelector.LinearCollectionSelector)
+	case selector.FilterSelector != nil || otherSelector.FilterSelector != nil:
+		return selector.FilterSelector != nil && otherSelector.FilterSelector != nil && selector.FilterSelector.String() == otherSelector.FilterSelector.String()
+	}
+
+	if len(selector.UnionSelector) != len(otherSelector.UnionSelector) {
+		return false
+	}
+	for i := range selector.UnionSelector {
+		if selector.UnionSelector[i] == nil || otherSelector.UnionSelector[i] == nil {
+			if selector.UnionSelector[i] != otherSelector.UnionSelector[i] {
+				return false
+			}
+			continue
+		}
+		if !selectorsEqual(selector.UnionSelector[i], otherSelector.UnionSelector[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// selectsEveryMember returns true if selector is a wildcard or a slice that selects every element e.g., `[0:]` or `[::1]`.
+func selectsEveryMember(selector *CollectionMemberSegment) bool {
+	if selector.IsKeyIndexAll {
+		return true
+	}
+	if selector.LinearCollectionSelector == nil {
+		return false
+	}
+	canonical := canonicalLinearCollectionSelector(selector.LinearCollectionSelector)
+	return canonical.Step == 1 && !canonical.IsStart && !canonical.IsEnd
+}
+
+// canonicalLinearCollectionSelector sets the default step of 1 and drops a start of 0 so that `[0:5:1]` and `[:5]` are the same.
+func canonicalLinearCollectionSelector(linearCollectionSelector *LinearCollectionSelector) LinearCollectionSelector {
+	canonical := *linearCollectionSelector
+	if !canonical.IsStep {
+		canonical.Step, canonical.IsStep = 1, true
+	}
+	if !canonical.IsStart {
+		canonical.Start = 0
+	}
+	if !canonical.IsEnd {
+		canonical.End = 0
+	}
+	if canonical.Step > 0 && canonical.IsStart && canonical.Start == 0 {
+		canonical.IsStart = false
+	}
+	return canonical
+}
@@ -0,0 +1,154 @@
+package path
+
+import (
+	"testing"
+)
+
+func TestPath_Matches(t *testing.T) {
+	for _, testData := range []struct {
+		Pattern  JSONPath
+		Concrete JSONPath
+		Expected bool
+	}{
+		{Pattern: "$.a..c", Concrete: "$.a.b[3].c", Expected: true},
+		{Pattern: "$.a..c", Concrete: "$.a.c", Expected: true},
+		{Pattern: "$.a..c", Concrete: "$.a.b[3].c.d", Expected: false},
+		{Pattern: "$.a..c", Concrete: "$.b.c", Expected: false},
+		{Pattern: "$..c", Concrete: "$.c.c", Expected: true},
+		{Pattern: "$..[0]..c", Concrete: "$.a[0].b.c", Expected: true},
+		{Pattern: "$..[0]..c", Concrete: "$.a[1].b.c", Expected: false},
+		{Pattern: "$.a.*[1]", Concrete: "$['a']['x'][1]", Expected: true},
+		{Pattern: "$.a['x','y'][0,1]", Concrete: "$.a.y[1]", Expected: true},
+		{Pattern: "$.a['x','y'][0,1]", Concrete: "$.a.z[1]", Expected: false},
+		{Pattern: "$.items[1:6:2]", Concrete: "$.items[5]", Expected: true},
+		{Pattern: "$.items[1:6:2]", Concrete: "$.items[4]", Expected: false},
+		{Pattern: "$.items[1:]", Concrete: "$.items[100]", Expected: true},
+		{Pattern: "$.items[-1]", Concrete: "$.items[2]", Expected: false},
+		{Pattern: "$.items[::-1]", Concrete: "$.items[2]", Expected: false},
+		{Pattern: "$.items[?@.a]", Concrete: "$.items[2]", Expected: false},
+		{Pattern: "$.items[0]", Concrete: "/items/0", Expected: true},
+		{Pattern: "$.items['0']", Concrete: "/items/0", Expected: true},
+		{Pattern: "$", Concrete: "$", Expected: true},
+		{Pattern: "$", Concrete: "$.a", Expected: false},
+		{Pattern: "$.a", Concrete: "$", Expected: false},
+		{Pattern: "$.a.*", Concrete: "$.a[*]", Expected: false},
+	} {
+		if result := testData.Pattern.Parse().Matches(testData.Concrete.Parse()[0]); result != testData.Expected {
+			t.Error("pattern=", testData.Pattern, "concrete=", testData.Concrete, "\n", "expected=", testData.Expected, "got=", result)
+		}
+	}
+}
+
+func TestPath_Overlaps(t *testing.T) {
+	for _, testData := range []struct {
+		Path     JSONPath
+		Other    JSONPath
+		Expected bool
+	}{
+		{Path: "$.a.b", Other: "$.a.b", Expected: true},
+		{Path: "$.a.b", Other: "$.a.c", Expected: false},
+		{Path: "$.a.b", Other: "$.a", Expected: false},
+		{Path: "$.a..c", Other: "$.*.b.c", Expected: true},
+		{Path: "$.a..c", Other: "$.b..c", Expected: false},
+		{Path: "$..c", Other: "$.a[0].c", Expected: true},
+		{Path: "$..c", Other: "$.a[0].d", Expected: false},
+		{Path: "$..a..b", Other: "$..b..b", Expected: true},
+		{Path: "$..a..b", Other: "$..b..a", Expected: false},
+		{Path: "$.a[0]", Other: "$.a[-1]", Expected: true},
+		{Path: "$.a[-1]", Other: "$.a[-2]", Expected: false},
+		{Path: "$.a[0]", Other: "$.a.b", Expected: false},
+		{Path: "$.a[1:6:2]", Other: "$.a[4]", Expected: false},
+		{Path: "$.a[1:6:2]", Other: "$.a[3]", Expected: true},
+		{Path: "$.a[::-1]", Other: "$.a[4]", Expected: true},
+		{Path: "$.a[::0]", Other: "$.a[*]", Expected: false},
+		{Path: "$.a[?@.x > 1]", Other: "$.a.b", Expected: true},
+		{Path: "$.a['x','y']", Other: "$.a['y','z']", Expected: true},
+		{Path: "$.a['x','y']", Other: "$.a[0,1]", Expected: false},
+		{Path: "/a/0", Other: "$.a['0']", Expected: true},
+		{Path: "/a/0", Other: "$.a[0]", Expected: true},
+	} {
+		if result := testData.Path.Parse().Overlaps(testData.Other.Parse()); result != testData.Expected {
+			t.Error("path=", testData.Path, "other=", testData.Other, "\n", "expected=", testData.Expected, "got=", result)
+		}
+		if result := testData.Other.Parse().Overlaps(testData.Path.Parse()); result != testData.Expected {
+			t.Error("expected Overlaps to be symmetric, path=", testData.Other, "other=", testData.Path, "\n", "expected=", testData.Expected, "got=", result)
+		}
+	}
+}
+
+func TestPath_IsPrefixOfAndEqual(t *testing.T) {
+	for _, testData := range []struct {
+		Path             JSONPath
+		Other            JSONPath
+		ExpectedIsPrefix bool
+		ExpectedEqual    bool
+	}{
+		{Path: "$.a.b", Other: "$.a.b[2]", ExpectedIsPrefix: true},
+		{Path: "$.a.b[2]", Other: "$.a.b", ExpectedIsPrefix: false},
+		{Path: "$.a..c", Other: "$.a..c.d", ExpectedIsPrefix: true},
+		{Path: "$.a.c", Other: "$.a..c", ExpectedIsPrefix: false},
+		{Path: "$", Other: "$..x", ExpectedIsPrefix: true},
+		{Path: "$.a", Other: "$['a']", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a.*", Other: "$.a[*]", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a[0:5:1]", Other: "$.a[:5]", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a[::-1]", Other: "$.a[0::-1]", ExpectedIsPrefix: false},
+		{Path: "$[?@.a==1]", Other: "$[?@.a == 1]", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$['a','b']", Other: "$['b','a']", ExpectedIsPrefix: false},
+		{Path: "/a/b", Other: "$.a.b", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "/a/0", Other: "$.a[0]", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a[0]", Other: "/a/0/b", ExpectedIsPrefix: true},
+		{Path: "/a/0", Other: "$.a['0']", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a[::1]", Other: "$.a[:]", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a[0:]", Other: "$.a[*]", ExpectedIsPrefix: true, ExpectedEqual: true},
+		{Path: "$.a[1:]", Other: "$.a[*]", ExpectedIsPrefix: false},
+		{Path: "$.a[::2]", Other: "$.a[:]", ExpectedIsPrefix: false},
+	} {
+		if result := testData.Path.Parse().IsPrefixOf(testData.Other.Parse()); result != testData.ExpectedIsPrefix {
+			t.Error("path=", testData.Path, "other=", testData.Other, "\n", "expected IsPrefixOf=", testData.ExpectedIsPrefix, "got=", result)
+		}
+		if result := testData.Path.Parse().Equal(testData.Other.Parse()); result != testData.ExpectedEqual {
+			t.Error("path=", testData.Path, "other=", testData.Other, "\n", "expected Equal=", testData.ExpectedEqual, "got=", result)
+		}
+	}
+
+	if !Root().UnionKeys("a").Segments().Equal(JSONPath("$.a").Parse()) {
+		t.Error("expected union with a single member to be equal to the member")
+	}
+}
+
+func TestPath_ParentRelativizeJoin(t *testing.T) {
+	for _, testData := range []struct {
+		Path               JSONPath
+		ExpectedParent     string
+		ExpectedParentOk   bool
+		Other              JSONPath
+		ExpectedRelative   string
+		ExpectedRelativeOk bool
+	}{
+		{Path: "$.a.b", ExpectedParent: "$.a", ExpectedParentOk: true, Other: "$.a.b[2].c", ExpectedRelative: "$[2].c", ExpectedRelativeOk: true},
+		{Path: "$.a", ExpectedParent: "$", ExpectedParentOk: true, Other: "$.a..c", ExpectedRelative: "$..c", ExpectedRelativeOk: true},
+		{Path: "$.a..c", ExpectedParent: "$.a", ExpectedParentOk: true, Other: "$.a..c[*]", ExpectedRelative: "$.*", ExpectedRelativeOk: true},
+		{Path: "$", ExpectedParentOk: false, Other: "$.x", ExpectedRelative: "$.x", ExpectedRelativeOk: true},
+		{Path: "$.a.b", ExpectedParent: "$.a", ExpectedParentOk: true, Other: "$.a.c", ExpectedRelativeOk: false},
+		{Path: "$.a.b", ExpectedParent: "$.a", ExpectedParentOk: true, Other: "$.a.b", ExpectedRelative: "$", ExpectedRelativeOk: true},
+	} {
+		segments := testData.Path.Parse()
+
+		parent, ok := segments.Parent()
+		if ok != testData.ExpectedParentOk || (ok && parent.String() != testData.ExpectedParent) {
+			t.Error("path=", testData.Path, "\n", "expected parent=", testData.ExpectedParent, testData.ExpectedParentOk, "got=", parent, ok)
+		}
+
+		relative, ok := segments.Relativize(testData.Other.Parse())
+		if ok != testData.ExpectedRelativeOk || (ok && relative.String() != testData.ExpectedRelative) {
+			t.Error("path=", testData.Path, "other=", testData.Other, "\n", "expected relative=", testData.ExpectedRelative, testData.ExpectedRelativeOk, "got=", relative, ok)
+			continue
+		}
+
+		if ok {
+			if joined := segments.Join(relative); !joined.Equal(testData.Other.Parse()) {
+				t.Error("path=", testData.Path, "relative=", relative, "\n", "expected join=", testData.Other, "got=", joined)
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package path
+
+/*
+Builder builds RecursiveDescentSegments programmatically without formatting and parsing a JSONPath string.
+
+Keys are used as they are hence they may contain any character including quotes, dots, and brackets.
+String escapes them so that the result can be parsed back into the same segments.
+
+Every method returns a new Builder so a Builder can be shared and extended in different directions.
+
+Example:
+
+	users := path.Root().Key("users")
+	jsonPath := users.Key(userKey).Key("roles").Index(i).JSONPath() // e.g., $.users['o\'brien'].roles[0]
+	names := users.Wildcard().Key("name").Segments()
+	titles := path.Root().Descend().Key("title").JSONPath() // $..title
+*/
+type Builder struct {
+	recursiveDescentSegments RecursiveDescentSegments
+	// descend is true if the next selector starts a new recursive descent segment.
+	descend bool
+}
+
+// Root returns a Builder for the root `$`.
+func Root() *Builder {
+	return &Builder{
+		recursiveDescentSegments: RecursiveDescentSegments{{{Key: JsonpathKeyRoot, IsKeyRoot: true, ExpectLinear: true, ExpectAssociative: true}}},
+	}
+}
+
+// Key selects the member key of an object, map, or struct.
+func (n *Builder) Key(key string) *Builder {
+	return n.with(&CollectionMemberSegment{Key: key, IsKey: true, ExpectAssociative: true})
+}
+
+// Index selects the element at index of an array or slice. Negative indexes count from the end.
+func (n *Builder) Index(index int) *Builder {
+	return n.with(&CollectionMemberSegment{Index: index, IsIndex: true, ExpectLinear: true})
+}
+
+// IndexEnd selects the end of an array or slice `[-]` where Object.Set and Object.Insert append a new element.
+func (n *Builder) IndexEnd() *Builder {
+	return n.with(&CollectionMemberSegment{Key: JsonpathIndexEnd, IsIndexEnd: true, ExpectLinear: true})
+}
+
+// Wildcard selects every member of a collection.
+func (n *Builder) Wildcard() *Builder {
+	return n.with(&CollectionMemberSegment{Key: JsonpathKeyIndexAll, IsKeyIndexAll: true, ExpectAssociative: true})
+}
+
+// Slice selects the elements of an array or slice with the slice selector e.g., `[1:5:2]`.
+func (n *Builder) Slice(linearCollectionSelector LinearCollectionSelector) *Builder {
+	return n.with(&CollectionMemberSegment{LinearCollectionSelector: &linearCollectionSelector, ExpectLinear: true})
+}
+
+// UnionKeys selects several member keys e.g., `['name','email']`.
+func (n *Builder) UnionKeys(keys ...string) *Builder {
+	members := make([]*CollectionMemberSegment, 0, len(keys))
+	for _, key := range keys {
+		members = append(members, &CollectionMemberSegment{Key: key, IsKey: true})
+	}
+	return n.Union(members...)
+}
+
+// UnionIndexes selects several elements e.g., `[0,-1]`.
+func (n *Builder) UnionIndexes(indexes ...int) *Builder {
+	members := make([]*CollectionMemberSegment, 0, len(indexes))
+	for _, index := range indexes {
+		members = append(members, &CollectionMemberSegment{Index: index, IsIndex: true})
+	}
+	return n.Union(members...)
+}
+
+/*
+Union selects several members of a collection e.g., `['name',0]`.
+
+Only members that are keys (IsKey) or indexes (IsIndex) are kept.
+*/
+func (n *Builder) Union(members ...*CollectionMemberSegment) *Builder {
+	unionSelector := make([]*CollectionMemberSegment, 0, len(members))
+	for _, member := range members {
+		switch {
+		case member == nil:
+			continue
+		case member.IsKey:
+			unionSelector = append(unionSelector, &CollectionMemberSegment{Key: member.Key, IsKey: true})
+		case member.IsIndex:
+			unionSelector = append(unionSelector, &CollectionMemberSegment{Index: member.Index, IsIndex: true})
+		}
+	}
+	return n.with(&CollectionMemberSegment{UnionSelector: unionSelector, ExpectLinear: true, ExpectAssociative: true})
+}
+
+/*
+Descend adds the recursive descent operator (`..`) so that the next selector is applied to the current value and all its descendants.
+
+It has no effect if it is not followed by a selector.
+*/
+func (n *Builder) Descend() *Builder {
+	builder := n.clone()
+	builder.descend = true
+	return builder
+}
+
+// Segments returns the path built. The returned slices are not shared with the Builder but the segments they point to are hence they must not be modified.
+func (n *Builder) Segments() RecursiveDescentSegments {
+	return n.clone().recursiveDescentSegments
+}
+
+// JSONPath returns the path built as a JSONPath string that can be parsed back into the same segments.
+func (n *Builder) JSONPath() JSONPath {
+	return JSONPath(n.String())
+}
+
+// String returns the path built as a JSONPath string that can be parsed back into the same segments.
+func (n *Builder) String() string {
+	return n.recursiveDescentSegments.String()
+}
+
+// Compile returns the path built as a CompiledPath without parsing it.
+func (n *Builder) Compile() *CompiledPath {
+	return &CompiledPath{jsonPath: n.JSONPath(), recursiveDescentSegments: n.Segments()}
+}
+
+// with returns a copy of the Builder with collectionMemberSegment added.
+func (n *Builder) with(collectionMemberSegment *CollectionMemberSegment) *Builder {
+	builder := n.clone()
+	if builder.descend {
+		builder.recursiveDescentSegments = append(builder.recursiveDescentSegments, RecursiveDescentSegment{collectionMemberSegment})
+		builder.descend = false
+		return builder
+	}
+
+	last := len(builder.recursiveDescentSegments) - 1
+	builder.recursiveDescentSegments[last] = append(builder.recursiveDescentSegments[last], collectionMemberSegment)
+	return builder
+}
+
+// clone returns a copy of the Builder whose slices do not share memory with n. The segments themselves are never modified hence they are shared.
+func (n *Builder) clone() *Builder {
+	recursiveDescentSegments := make(RecursiveDescentSegments, len(n.recursiveDescentSegments))
+	for i, recursiveDescentSegment := range n.recursiveDescentSegments {
+		recursiveDescentSegments[i] = append(make(RecursiveDescentSegment, 0, len(recursiveDescentSegment)+1), recursiveDescentSegment...)
+	}
+	return &Builder{recursiveDescentSegments: recursiveDescentSegments, descend: n.descend}
+}
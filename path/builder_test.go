@@ -0,0 +1,73 @@
+package path
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPath_Builder(t *testing.T) {
+	for _, testData := range []struct {
+		Builder  *Builder
+		Expected string
+	}{
+		{Builder: Root(), Expected: "$"},
+		{Builder: Root().Key("users").Key("alice").Key("roles").Index(0), Expected: "$.users.alice.roles[0]"},
+		{Builder: Root().Key("users").Key("o'brien").Key("a.b").Key(`back\slash`).Index(-1), Expected: `$.users['o\'brien']['a.b']['back\\slash'][-1]`},
+		{Builder: Root().Key("").Key("$").Key("*").Key("1st").Key("line\nbreak"), Expected: `$['']['$']['*']['1st']['line\nbreak']`},
+		{Builder: Root().Key("store").Wildcard().Key("price"), Expected: "$.store.*.price"},
+		{Builder: Root().Descend().Key("title"), Expected: "$..title"},
+		{Builder: Root().Key("store").Descend().Descend().Key("price").Descend().Wildcard(), Expected: "$.store..price..*"},
+		{Builder: Root().Key("store").Descend().Index(0), Expected: "$.store..[0]"},
+		{Builder: Root().UnionKeys("name", "it's", "a,b").UnionIndexes(0, -1), Expected: `$['name','it\'s','a,b'][0,-1]`},
+		{Builder: Root().Union(&CollectionMemberSegment{Key: "name", IsKey: true}, &CollectionMemberSegment{Index: 2, IsIndex: true}, nil, &CollectionMemberSegment{IsKeyIndexAll: true}), Expected: "$['name',2]"},
+		{Builder: Root().Key("items").Slice(LinearCollectionSelector{Start: 1, IsStart: true, Step: -1, IsStep: true}), Expected: "$.items[1::-1]"},
+		{Builder: Root().Key("trailing").Descend(), Expected: "$.trailing"},
+		{Builder: Root().Key("items").IndexEnd().Key("name"), Expected: "$.items[-].name"},
+	} {
+		if str := testData.Builder.String(); str != testData.Expected {
+			t.Error("expected=", testData.Expected, "\n", "got=", str)
+			continue
+		}
+
+		segments, err := testData.Builder.JSONPath().ParseStrict()
+		if err != nil {
+			t.Error("expected path to parse, path=", testData.Builder.JSONPath(), "err=", err)
+			continue
+		}
+
+		if !reflect.DeepEqual(segments, testData.Builder.Segments()) {
+			t.Error(
+				"path=", testData.Expected, "\n",
+				"expected parsed segments to be equal to built segments\n",
+				"parsed=", segments, "\n",
+				"built=", testData.Builder.Segments(),
+			)
+		}
+
+		if segments.String() != testData.Expected {
+			t.Error("expected roundtrip=", testData.Expected, "\n", "got=", segments.String())
+		}
+	}
+}
+
+func TestPath_BuilderIsImmutable(t *testing.T) {
+	users := Root().Key("users")
+	first := users.Index(0)
+	second := users.Index(1)
+	descend := users.Descend()
+	name := descend.Key("name")
+
+	if users.String() != "$.users" || first.String() != "$.users[0]" || second.String() != "$.users[1]" || descend.String() != "$.users" || name.String() != "$.users..name" {
+		t.Error("expected builders not to affect each other, got=", users, first, second, descend, name)
+	}
+
+	segments := first.Segments()
+	segments[0] = append(segments[0], &CollectionMemberSegment{Key: "extra", IsKey: true})
+	if first.String() != "$.users[0]" {
+		t.Error("expected segments to not be shared with the builder, got=", first)
+	}
+
+	if compiledPath := second.Compile(); compiledPath.JSONPath() != "$.users[1]" || !reflect.DeepEqual(compiledPath.Segments(), second.Segments()) {
+		t.Error("expected compiled path to be equal to built path, got=", compiledPath)
+	}
+}
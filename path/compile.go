@@ -0,0 +1,145 @@
+package path
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+)
+
+/*
+CompiledPath is a parsed JSONPath that can be reused across many queries without parsing the path again.
+
+A CompiledPath is immutable and safe for concurrent use. Create one with Compile, MustCompile, or CompiledPathCache.Compile.
+*/
+type CompiledPath struct {
+	jsonPath                 JSONPath
+	recursiveDescentSegments RecursiveDescentSegments
+}
+
+/*
+Compile parses jsonPath once for use with the Object methods that accept a CompiledPath e.g., `Object.GetCompiled`.
+
+Unlike Parse, the path must conform to the RFC 9535 grammar or be a valid JSONPointer, otherwise an error is returned.
+
+Example:
+
+	compiledPath, err := path.Compile("$.store.book[?@.price < 10].title")
+*/
+func Compile(jsonPath JSONPath) (*CompiledPath, error) {
+	if strings.HasPrefix(string(jsonPath), JsonPointerSeparator) {
+		segment, err := JSONPointer(jsonPath).Parse()
+		if err != nil {
+			return nil, err
+		}
+		return &CompiledPath{jsonPath: jsonPath, recursiveDescentSegments: RecursiveDescentSegments{segment}}, nil
+	}
+
+	recursiveDescentSegments, err := jsonPath.ParseStrict()
+	if err != nil {
+		return nil, err
+	}
+	return &CompiledPath{jsonPath: jsonPath, recursiveDescentSegments: recursiveDescentSegments}, nil
+}
+
+// MustCompile is like Compile but panics if jsonPath cannot be compiled. Useful for package level variables.
+func MustCompile(jsonPath JSONPath) *CompiledPath {
+	compiledPath, err := Compile(jsonPath)
+	if err != nil {
+		panic(err)
+	}
+	return compiledPath
+}
+
+// JSONPath returns the path that was compiled.
+func (n *CompiledPath) JSONPath() JSONPath {
+	return n.jsonPath
+}
+
+// String returns the path that was compiled.
+func (n *CompiledPath) String() string {
+	return string(n.jsonPath)
+}
+
+/*
+Segments returns the parsed path.
+
+The segments are shared by every user of the CompiledPath hence they must not be modified.
+*/
+func (n *CompiledPath) Segments() RecursiveDescentSegments {
+	return n.recursiveDescentSegments
+}
+
+// DefaultCompiledPathCacheCapacity is the capacity of a CompiledPathCache created with a capacity less than 1.
+const DefaultCompiledPathCacheCapacity = 256
+
+/*
+CompiledPathCache is a least recently used cache of CompiledPath keyed by the path string.
+
+Useful when paths are only known at runtime but the same paths are used repeatedly e.g., paths read from configuration.
+
+It is safe for concurrent use.
+*/
+type CompiledPathCache struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[JSONPath]*list.Element
+	// order holds *CompiledPath with the most recently used at the front.
+	order *list.List
+}
+
+// NewCompiledPathCache creates a CompiledPathCache that holds up to capacity compiled paths.
+func NewCompiledPathCache(capacity int) *CompiledPathCache {
+	if capacity < 1 {
+		capacity = DefaultCompiledPathCacheCapacity
+	}
+	return &CompiledPathCache{
+		capacity: capacity,
+		entries:  make(map[JSONPath]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+/*
+Compile returns the cached CompiledPath for jsonPath or compiles and caches it.
+
+Paths that fail to compile are not cached. When the cache is full, the least recently used path is evicted.
+*/
+func (n *CompiledPathCache) Compile(jsonPath JSONPath) (*CompiledPath, error) {
+	n.mutex.Lock()
+	if element, ok := n.entries[jsonPath]; ok {
+		n.order.MoveToFront(element)
+		n.mutex.Unlock()
+		return element.Value.(*CompiledPath), nil
+	}
+	n.mutex.Unlock()
+
+	compiledPath, err := Compile(jsonPath)
+	if err != nil {
+		return nil, err
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	// another goroutine may have compiled the same path in the meantime.
+	if element, ok := n.entries[jsonPath]; ok {
+		n.order.MoveToFront(element)
+		return element.Value.(*CompiledPath), nil
+	}
+
+	n.entries[jsonPath] = n.order.PushFront(compiledPath)
+	if n.order.Len() > n.capacity {
+		oldest := n.order.Back()
+		n.order.Remove(oldest)
+		delete(n.entries, oldest.Value.(*CompiledPath).jsonPath)
+	}
+
+	return compiledPath, nil
+}
+
+// Len returns the number of compiled paths in the cache.
+func (n *CompiledPathCache) Len() int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.order.Len()
+}
@@ -0,0 +1,154 @@
+package path
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPath_Compile(t *testing.T) {
+	for _, testData := range []struct {
+		JSONPath   JSONPath
+		ExpectedOk bool
+	}{
+		{JSONPath: "$", ExpectedOk: true},
+		{JSONPath: "$.store.book[?@.price < 10].title", ExpectedOk: true},
+		{JSONPath: "$..book[-1:]", ExpectedOk: true},
+		{JSONPath: "/store/book/0", ExpectedOk: true},
+		{JSONPath: "$.store.book[0", ExpectedOk: false},
+		{JSONPath: "/store/~2", ExpectedOk: false},
+	} {
+		compiledPath, err := Compile(testData.JSONPath)
+		if !testData.ExpectedOk {
+			if err == nil {
+				t.Error("path=", testData.JSONPath, "\n", "expected error")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error("path=", testData.JSONPath, "\n", "expected no error, got err=", err)
+			continue
+		}
+
+		if compiledPath.JSONPath() != testData.JSONPath || compiledPath.String() != string(testData.JSONPath) {
+			t.Error("path=", testData.JSONPath, "\n", "got compiled path=", compiledPath)
+		}
+
+		if !reflect.DeepEqual(compiledPath.Segments(), testData.JSONPath.Parse()) {
+			t.Error(
+				"path=", testData.JSONPath, "\n",
+				"expected=", testData.JSONPath.Parse(), "\n",
+				"got=", compiledPath.Segments(),
+			)
+		}
+	}
+}
+
+func TestPath_MustCompile(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected MustCompile to panic")
+		} else if err, ok := r.(error); !ok || !errors.Is(err, ErrJSONPathSyntaxError) {
+			t.Error("expected panic with ErrJSONPathSyntaxError, got=", r)
+		}
+	}()
+	MustCompile("$[")
+}
+
+func TestPath_CompiledPathCache(t *testing.T) {
+	cache := NewCompiledPathCache(2)
+
+	first, err := cache.Compile("$.a")
+	if err != nil {
+		t.Fatal("expected no error, got err=", err)
+	}
+	if again, _ := cache.Compile("$.a"); again != first {
+		t.Error("expected cached compiled path to be returned")
+	}
+
+	_, _ = cache.Compile("$.b")
+	// $.a is now the most recently used so $.b is evicted.
+	_, _ = cache.Compile("$.a")
+	_, _ = cache.Compile("$.c")
+	if cache.Len() != 2 {
+		t.Error("expected cache length=2, got=", cache.Len())
+	}
+	if again, _ := cache.Compile("$.a"); again != first {
+		t.Error("expected $.a to remain in the cache")
+	}
+
+	if _, err := cache.Compile("$["); err == nil {
+		t.Error("expected error for invalid path")
+	}
+	if cache.Len() != 2 {
+		t.Error("expected invalid path not to be cached, got length=", cache.Len())
+	}
+
+	if NewCompiledPathCache(0).capacity != DefaultCompiledPathCacheCapacity {
+		t.Error("expected default capacity")
+	}
+}
+
+func TestPath_CompiledPathCacheConcurrent(t *testing.T) {
+	cache := NewCompiledPathCache(8)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				jsonPath := JSONPath(fmt.Sprintf("$.items[%d].name", (i+j)%12))
+				compiledPath, err := cache.Compile(jsonPath)
+				if err != nil || compiledPath.JSONPath() != jsonPath {
+					t.Error("path=", jsonPath, "\n", "got compiled path=", compiledPath, "err=", err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.Len() > 8 {
+		t.Error("expected cache length <= 8, got=", cache.Len())
+	}
+}
+
+var benchmarkJSONPaths = []JSONPath{
+	"$.store.book[0].title",
+	"$..book[?@.price < 10].title",
+	"$.store.book[1:5:2].author",
+	"$['store']['bicycle']['color']",
+}
+
+func BenchmarkPath_Parse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, jsonPath := range benchmarkJSONPaths {
+			_ = jsonPath.Parse()
+		}
+	}
+}
+
+func BenchmarkPath_ParseLenient(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, jsonPath := range benchmarkJSONPaths {
+			_ = jsonPath.parseLenient()
+		}
+	}
+}
+
+func BenchmarkPath_CompiledPathCache(b *testing.B) {
+	cache := NewCompiledPathCache(len(benchmarkJSONPaths))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, jsonPath := range benchmarkJSONPaths {
+			_, _ = cache.Compile(jsonPath)
+		}
+	}
+}
@@ -1,10 +1,34 @@
 package path
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/rogonion/go-json/core"
 )
 
+var (
+	// ErrPathError is the default error.
+	ErrPathError = errors.New("path processing failed")
+
+	// ErrJSONPathSyntaxError for when a JSONPath does not conform to the RFC 9535 grammar.
+	ErrJSONPathSyntaxError = errors.New("jsonpath syntax error")
+
+	// ErrFilterFunctionError for when a FilterFunction cannot be registered.
+	ErrFilterFunctionError = errors.New("filter function error")
+
+	// ErrJSONPointerError for when a JSONPointer is invalid or a path cannot be converted to a JSONPointer.
+	ErrJSONPointerError = errors.New("json pointer error")
+)
+
+// NewError creates a new core.Error with the default base error ErrPathError.
+func NewError() *core.Error {
+	n := core.NewError().WithDefaultBaseError(ErrPathError)
+	return n
+}
+
 /*
 JSONPath is an alias for a string intended to represent a JSON path query.
 Example: "$.store.book[0].title"
@@ -26,6 +50,9 @@ type RecursiveDescentSegment []*CollectionMemberSegment
 /*
 LinearCollectionSelector represents a slice selector for linear collections (arrays/slices).
 Syntax: [start:end:step]
+
+Negative Start and End count from the end of the collection and a negative Step selects elements in reverse order.
+Use LinearCollectionSelector.Indices to get the indexes selected in a collection of a given length.
 */
 type LinearCollectionSelector struct {
 	Start   int  // The starting index (inclusive)
@@ -47,18 +74,25 @@ type CollectionMemberSegment struct {
 	// IsKeyIndexAll is true if the segment is a wildcard '*'.
 	IsKeyIndexAll bool
 	// IsKeyRoot is true if the segment is the root '$'.
-	IsKeyRoot                bool
-	Index                    int
-	IsIndex                  bool
+	IsKeyRoot bool
+	Index     int
+	IsIndex   bool
+	// IsIndexEnd is true if the segment is the end of a linear collection '-' e.g., `$.items[-]` or `/items/-`. It selects no element but is where Object.Set appends.
+	IsIndexEnd               bool
 	ExpectLinear             bool
 	ExpectAssociative        bool
 	LinearCollectionSelector *LinearCollectionSelector
 	UnionSelector            []*CollectionMemberSegment
+	// FilterSelector is set if the segment is a filter selector e.g., `[?@.price < 10]`.
+	FilterSelector *FilterExpression
 }
 
 const (
 	JsonpathKeyIndexAll              string = "*"
+	JsonpathIndexEnd                 string = "-"
 	JsonpathKeyRoot                  string = "$"
+	JsonpathKeyCurrent               string = "@"
+	JsonpathFilter                   string = "?"
 	JsonpathDotNotation              string = "."
 	JsonpathRecursiveDescentNotation string = ".."
 	JsonpathLeftBracket              string = "["
@@ -67,46 +101,45 @@ const (
 
 // getJsonKey formats a string key for JSONPath output.
 // It returns the key as-is if it's a valid identifier, otherwise wraps it in brackets and quotes.
-// E.g., "name" -> "name", "first-name" -> "['first-name']"
+// E.g., "name" -> "name", "first-name" -> "['first-name']", "it's" -> "['it\'s']"
 func getJsonKey(value string) string {
-	if jsonKeyBeginDoesNotNeedBracketsRegex().MatchString(value) {
-		if !jsonKeyRemainingNeedBracketsRegex().MatchString(value) {
+	if jsonKeyBeginDoesNotNeedBracketsRegex.MatchString(value) {
+		if !jsonKeyRemainingNeedBracketsRegex.MatchString(value) {
 			return value
 		}
 	}
-	return fmt.Sprintf("['%s']", value)
+	return fmt.Sprintf("%s%s%s", JsonpathLeftBracket, getQuotedJsonKey(value), JsonpathRightBracket)
 }
 
-// jsonKeyBeginDoesNotNeedBracketsRegex matches keys starting with a letter.
-func jsonKeyBeginDoesNotNeedBracketsRegex() *regexp.Regexp {
-	return regexp.MustCompile("^[a-zA-Z]")
+// getQuotedJsonKey returns value in single quotes with quotes, backslashes, and control characters escaped. E.g., "it's" -> "'it\'s'"
+func getQuotedJsonKey(value string) string {
+	var builder strings.Builder
+	builder.WriteByte('\'')
+	writeNormalizedKey(&builder, value)
+	builder.WriteByte('\'')
+	return builder.String()
 }
 
-// jsonKeyRemainingNeedBracketsRegex matches keys containing characters that require brackets.
-func jsonKeyRemainingNeedBracketsRegex() *regexp.Regexp {
-	return regexp.MustCompile("[^a-zA-Z0-9_]")
-}
+// Patterns used by getJsonKey and the lenient parser. They are compiled once as compiling them on every call dominated the cost of parsing.
+var (
+	// jsonKeyBeginDoesNotNeedBracketsRegex matches keys starting with a letter.
+	jsonKeyBeginDoesNotNeedBracketsRegex = regexp.MustCompile("^[a-zA-Z]")
 
-// unionMemberPatternRegex matches individual members inside a union selector (integers or quoted strings).
-func unionMemberPatternRegex() *regexp.Regexp {
-	return regexp.MustCompile(`(\d+)|["']([^"']+)["']`)
-}
+	// jsonKeyRemainingNeedBracketsRegex matches keys containing characters that require brackets.
+	jsonKeyRemainingNeedBracketsRegex = regexp.MustCompile("[^a-zA-Z0-9_]")
 
-// arraySelectorPatternRegex matches the array slice syntax start:end:step.
-func arraySelectorPatternRegex() *regexp.Regexp {
-	return regexp.MustCompile(`(\d*):(\d*):(\d*)`)
-}
+	// unionMemberPatternRegex matches individual members inside a union selector (integers or quoted strings).
+	unionMemberPatternRegex = regexp.MustCompile(`(-?\d+)|["']([^"']+)["']`)
 
-// collectionMemberSegmentPatternRegex matches various forms of path segments (index, slice, quoted key, union, simple key).
-func collectionMemberSegmentPatternRegex() *regexp.Regexp {
-	return regexp.MustCompile(`\[(\d+|\*)]|\[(\d*:\d*:\d*)]|\[["']([^"']+)["']]|\[((?:[^,\n]+,?)+)]|([a-zA-Z0-9$*_]+)`)
-}
+	// arraySelectorPatternRegex matches the array slice syntax start:end:step.
+	arraySelectorPatternRegex = regexp.MustCompile(`(-?\d*):(-?\d*):(-?\d*)`)
 
-// recursiveDescentPatternRegex matches segments separated by '..'.
-func recursiveDescentPatternRegex() *regexp.Regexp {
-	return regexp.MustCompile(`\[(?:["'][^"']+["']|[^]])+]|([.]{2})`)
-}
+	// collectionMemberSegmentPatternRegex matches various forms of path segments (index, slice, quoted key, union, simple key).
+	collectionMemberSegmentPatternRegex = regexp.MustCompile(`\[(-?\d+|\*)]|\[(-?\d*:-?\d*:-?\d*)]|\[["']([^"']+)["']]|\[((?:[^,\n]+,?)+)]|([a-zA-Z0-9$*_]+)`)
 
-func memberDotNotationPatternRegex() *regexp.Regexp {
-	return regexp.MustCompile(`\[(?:["'][^"']+["']|[^]])+]|([.])`)
-}
+	// recursiveDescentPatternRegex matches segments separated by '..'.
+	recursiveDescentPatternRegex = regexp.MustCompile(`\[(?:["'][^"']+["']|[^]])+]|([.]{2})`)
+
+	// memberDotNotationPatternRegex matches segments separated by '.'.
+	memberDotNotationPatternRegex = regexp.MustCompile(`\[(?:["'][^"']+["']|[^]])+]|([.])`)
+)
@@ -10,9 +10,12 @@ The package supports a subset of the JSONPath specification, including:
   - Bracket notation (`['key']`, `["key"]`)
   - Recursive descent (`..`)
   - Wildcards (`*`)
-  - Array/Slice selectors (`[start:end:step]`)
+  - Array/Slice selectors (`[start:end:step]`, `[-3:]`, `[::-1]`)
   - Union selectors (`['key1','key2']`, `[1,3,5]`)
-  - Index selectors (`[0]`, `[1]`)
+  - Index selectors (`[0]`, `[1]`, `[-1]` for the last element)
+  - End of array selector (`[-]`) from RFC 6902 which selects no element but is where new elements are appended
+  - Filter selectors (`[?@.price < 10]`, `[?@.status == 'open' && @.total > 100]`, `[?@.isbn]`)
+  - Filter function extensions (`length()`, `count()`, `match()`, `search()`, `value()`) and custom functions added with RegisterFilterFunction
 
 # Usage
 
@@ -20,5 +23,36 @@ To parse a JSONPath string:
 
 	var jsonPath JSONPath = "$[1,3,5]"
 	var parsedPath RecursiveDescentSegments = jsonPath.Parse()
+
+To parse a JSONPath string and reject anything that does not conform to RFC 9535:
+
+	parsedPath, err := jsonPath.ParseStrict()
+
+JSON Pointers (RFC 6901) e.g., `/store/book/0/title` are supported through JSONPointer.
+JSONPath.Parse also accepts a JSON Pointer hence it can be used wherever a JSONPath is expected:
+
+	segment, err := JSONPointer("/store/book/0/title").Parse()
+	jsonPointer, err := JSONPath("$.store.book[0].title").JSONPointer() // fails if the path is not singular
+
+To build a path from user data without formatting a string, use the Builder. Keys are escaped when the path is converted to a string:
+
+	jsonPath := Root().Key("users").Key(userKey).Key("roles").Index(i).JSONPath()
+	segments := Root().Descend().Key("price").Segments() // $..price
+
+Paths can be compared without a document, e.g. for access rules keyed by path:
+
+	pattern := JSONPath("$.a..c").Parse()
+	pattern.Matches(JSONPath("$.a.b[3].c").Parse()[0])      // true
+	JSONPath("$.a.b").Parse().IsPrefixOf(JSONPath("$.a.b[2]").Parse()) // true
+	pattern.Overlaps(JSONPath("$.*.b.c").Parse())               // true
+
+Parent, Relativize, Join, and Equal are also available on RecursiveDescentSegments.
+
+To parse a path once and reuse it, compile it. A CompiledPathCache compiles paths that are only known at runtime once and keeps the most recently used:
+
+	compiledPath, err := Compile("$.store.book[?@.price < 10].title")
+
+	cache := NewCompiledPathCache(64)
+	compiledPath, err = cache.Compile(jsonPathFromConfig)
 */
 package path
@@ -12,7 +12,7 @@ It identifies and extracts various selector types such as indices (`[1]`), wildc
 func (jsonPath JSONPath) ExtractCollectionMemberSegments() RecursiveDescentSegment {
 	collectionMemberSegments := make(RecursiveDescentSegment, 0)
 
-	matches := collectionMemberSegmentPatternRegex().FindAllStringSubmatch(string(jsonPath), -1)
+	matches := collectionMemberSegmentPatternRegex.FindAllStringSubmatch(string(jsonPath), -1)
 
 	for _, match := range matches {
 		for j, segment := range match {
@@ -36,7 +36,7 @@ func (jsonPath JSONPath) ExtractCollectionMemberSegments() RecursiveDescentSegme
 					collectionMemberSegment.ExpectLinear = true
 				}
 			case 2: // E.g. [1:5:2] , [1::]
-				startEndStepMatch := arraySelectorPatternRegex().FindStringSubmatch(segment)
+				startEndStepMatch := arraySelectorPatternRegex.FindStringSubmatch(segment)
 				if len(startEndStepMatch) == 0 {
 					break
 				}
@@ -76,7 +76,7 @@ func (jsonPath JSONPath) ExtractCollectionMemberSegments() RecursiveDescentSegme
 				collectionMemberSegment.IsKey = true
 				collectionMemberSegment.ExpectAssociative = true
 			case 4: // E.g. ['theme-settings',"font-size",3]
-				unionMemberMatch := unionMemberPatternRegex().FindAllStringSubmatch(segment, -1)
+				unionMemberMatch := unionMemberPatternRegex.FindAllStringSubmatch(segment, -1)
 
 				if len(unionMemberMatch) == 0 {
 					break
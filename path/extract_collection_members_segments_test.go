@@ -448,4 +448,37 @@ func ExtractCollectionMemberSegmentsTestData(yield func(data *ExtractCollectionM
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&ExtractCollectionMemberSegmentsData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: negative index and slice", testCaseIndex),
+			},
+			Segment: "list[-1][-3::-1]",
+			ExpectedSegments: RecursiveDescentSegment{
+				{
+					Key:               "list",
+					IsKey:             true,
+					ExpectAssociative: true,
+				},
+				{
+					Index:        -1,
+					IsIndex:      true,
+					ExpectLinear: true,
+				},
+				{
+					LinearCollectionSelector: &LinearCollectionSelector{
+						Start:   -3,
+						IsStart: true,
+						Step:    -1,
+						IsStep:  true,
+					},
+					ExpectLinear: true,
+				},
+			},
+		},
+	) {
+		return
+	}
 }
@@ -0,0 +1,191 @@
+package path
+
+import (
+	"strconv"
+	"strings"
+)
+
+/*
+FilterOperator identifies the kind of node in a FilterExpression.
+*/
+type FilterOperator int
+
+const (
+	// FilterOperatorOr is true if any of the Operands is true.
+	FilterOperatorOr FilterOperator = iota + 1
+	// FilterOperatorAnd is true if all the Operands are true.
+	FilterOperatorAnd
+	// FilterOperatorNot negates its only operand.
+	FilterOperatorNot
+	// FilterOperatorExists is true if the FilterQuery in its only operand selects at least one value.
+	FilterOperatorExists
+	FilterOperatorEqual
+	FilterOperatorNotEqual
+	FilterOperatorLess
+	FilterOperatorLessOrEqual
+	FilterOperatorGreater
+	FilterOperatorGreaterOrEqual
+	// FilterOperatorLiteral is a leaf holding a Literal value.
+	FilterOperatorLiteral
+	// FilterOperatorQuery is a leaf holding a Query.
+	FilterOperatorQuery
+	// FilterOperatorFunction calls the FilterFunction named Function with Operands as its arguments.
+	FilterOperatorFunction
+)
+
+// IsComparison returns true if the operator compares two operands e.g., `==` or `<`.
+func (n FilterOperator) IsComparison() bool {
+	return n >= FilterOperatorEqual && n <= FilterOperatorGreaterOrEqual
+}
+
+// String returns the JSONPath representation of a logical or comparison operator.
+func (n FilterOperator) String() string {
+	switch n {
+	case FilterOperatorOr:
+		return "||"
+	case FilterOperatorAnd:
+		return "&&"
+	case FilterOperatorNot:
+		return "!"
+	case FilterOperatorEqual:
+		return "=="
+	case FilterOperatorNotEqual:
+		return "!="
+	case FilterOperatorLess:
+		return "<"
+	case FilterOperatorLessOrEqual:
+		return "<="
+	case FilterOperatorGreater:
+		return ">"
+	case FilterOperatorGreaterOrEqual:
+		return ">="
+	default:
+		return ""
+	}
+}
+
+/*
+FilterExpression is a node in the abstract syntax tree of a filter selector e.g., `[?@.status == 'open' && @.total > 100]`.
+
+Logical and comparison nodes hold their sub-expressions in Operands.
+Leaves are either a FilterOperatorLiteral or a FilterOperatorQuery.
+Function calls hold their arguments in Operands.
+*/
+type FilterExpression struct {
+	Operator FilterOperator
+	Operands []*FilterExpression
+	// Literal is one of nil, bool, string, int64 or float64.
+	Literal any
+	Query   *FilterQuery
+	// Function is the name of the FilterFunction to call.
+	Function string
+}
+
+/*
+FilterQuery is a JSONPath query embedded in a filter expression.
+
+A relative query starts at the current node (`@`) being filtered while an absolute query starts at the root (`$`) of the source.
+Segments always begin with the root segment.
+*/
+type FilterQuery struct {
+	IsRelative bool
+	Segments   RecursiveDescentSegments
+}
+
+/*
+IsSingular returns true if the query can select at most one value i.e., it only contains name and index selectors and no recursive descent.
+
+Only singular queries can be compared with other values.
+*/
+func (n *FilterQuery) IsSingular() bool {
+	if n == nil || len(n.Segments) != 1 {
+		return false
+	}
+	for _, segment := range n.Segments[0] {
+		if !segment.IsKeyRoot && !segment.IsKey && !segment.IsIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the JSONPath representation of the query.
+func (n *FilterQuery) String() string {
+	if n == nil {
+		return ""
+	}
+	str := n.Segments.String()
+	if n.IsRelative {
+		return JsonpathKeyCurrent + strings.TrimPrefix(str, JsonpathKeyRoot)
+	}
+	return str
+}
+
+// String returns the JSONPath representation of the expression.
+func (n *FilterExpression) String() string {
+	if n == nil {
+		return ""
+	}
+
+	switch {
+	case n.Operator == FilterOperatorOr || n.Operator == FilterOperatorAnd:
+		operandsStr := make([]string, 0, len(n.Operands))
+		for _, operand := range n.Operands {
+			operandStr := operand.String()
+			if n.Operator == FilterOperatorAnd && operand.Operator == FilterOperatorOr {
+				operandStr = "(" + operandStr + ")"
+			}
+			operandsStr = append(operandsStr, operandStr)
+		}
+		return strings.Join(operandsStr, " "+n.Operator.String()+" ")
+	case n.Operator == FilterOperatorNot:
+		if len(n.Operands) == 1 && (n.Operands[0].Operator == FilterOperatorExists || n.Operands[0].Operator == FilterOperatorFunction) {
+			return n.Operator.String() + n.Operands[0].String()
+		}
+		if len(n.Operands) == 1 {
+			return n.Operator.String() + "(" + n.Operands[0].String() + ")"
+		}
+	case n.Operator == FilterOperatorExists:
+		if len(n.Operands) == 1 {
+			return n.Operands[0].String()
+		}
+	case n.Operator.IsComparison():
+		if len(n.Operands) == 2 {
+			return n.Operands[0].String() + " " + n.Operator.String() + " " + n.Operands[1].String()
+		}
+	case n.Operator == FilterOperatorLiteral:
+		return literalString(n.Literal)
+	case n.Operator == FilterOperatorQuery:
+		return n.Query.String()
+	case n.Operator == FilterOperatorFunction:
+		argumentsStr := make([]string, 0, len(n.Operands))
+		for _, operand := range n.Operands {
+			argumentsStr = append(argumentsStr, operand.String())
+		}
+		return n.Function + "(" + strings.Join(argumentsStr, ", ") + ")"
+	}
+	return ""
+}
+
+// literalString formats a filter literal so that it can be parsed back.
+func literalString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		// keep the literal a float64 when it is parsed back.
+		str := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(str, ".e") {
+			str += ".0"
+		}
+		return str
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\b", `\b`, "\f", `\f`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(v) + "'"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,332 @@
+package path
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/rogonion/go-json/core"
+)
+
+/*
+FilterFunctionType is the type of a filter function parameter or result as defined in RFC 9535 section 2.4.1.
+*/
+type FilterFunctionType int
+
+const (
+	// FilterFunctionTypeValue is a single value or Nothing e.g., a literal or the value selected by a singular query.
+	FilterFunctionTypeValue FilterFunctionType = iota + 1
+	// FilterFunctionTypeLogical is true or false e.g., the result of a logical expression.
+	FilterFunctionTypeLogical
+	// FilterFunctionTypeNodes is a list of values e.g., the values selected by a filter query.
+	FilterFunctionTypeNodes
+)
+
+// String returns the name of the type as used in RFC 9535.
+func (n FilterFunctionType) String() string {
+	switch n {
+	case FilterFunctionTypeValue:
+		return "ValueType"
+	case FilterFunctionTypeLogical:
+		return "LogicalType"
+	case FilterFunctionTypeNodes:
+		return "NodesType"
+	default:
+		return ""
+	}
+}
+
+/*
+FilterFunctionValue is an argument passed to or the result returned by a FilterFunction.
+
+Only the fields matching Type are used:
+  - FilterFunctionTypeValue - Value holds the value. IsNothing is true if there is no value which is distinct from a null value (an invalid or nil Value).
+  - FilterFunctionTypeLogical - Logical holds the value.
+  - FilterFunctionTypeNodes - Nodes holds the values selected by a query.
+
+Values are the reflected values in the object being queried hence they may be pointers, interfaces, structs, maps with non-string keys, or arrays.
+*/
+type FilterFunctionValue struct {
+	Type      FilterFunctionType
+	Value     reflect.Value
+	IsNothing bool
+	Logical   bool
+	Nodes     []reflect.Value
+
+	// Resolves the fields of structs in Value and Nodes the same way as the path being evaluated. nil uses core.FieldNameGo.
+	FieldNameStrategy *core.FieldNameStrategy
+}
+
+/*
+FilterFunction is a function extension that can be called in a filter selector e.g., `$.users[?length(@.roles) > 2]`.
+
+Parameters and Result are used to check that the function is well-typed when the path is parsed.
+Evaluate receives one argument for each of the Parameters and must return a value of type Result.
+*/
+type FilterFunction struct {
+	Name       string
+	Parameters []FilterFunctionType
+	Result     FilterFunctionType
+	Evaluate   func(arguments []FilterFunctionValue) FilterFunctionValue
+}
+
+var (
+	filterFunctions      = make(map[string]*FilterFunction)
+	filterFunctionsMutex sync.RWMutex
+
+	filterFunctionNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+func init() {
+	for _, function := range []*FilterFunction{
+		{Name: "length", Parameters: []FilterFunctionType{FilterFunctionTypeValue}, Result: FilterFunctionTypeValue, Evaluate: filterFunctionLength},
+		{Name: "count", Parameters: []FilterFunctionType{FilterFunctionTypeNodes}, Result: FilterFunctionTypeValue, Evaluate: filterFunctionCount},
+		{Name: "match", Parameters: []FilterFunctionType{FilterFunctionTypeValue, FilterFunctionTypeValue}, Result: FilterFunctionTypeLogical, Evaluate: filterFunctionMatch},
+		{Name: "search", Parameters: []FilterFunctionType{FilterFunctionTypeValue, FilterFunctionTypeValue}, Result: FilterFunctionTypeLogical, Evaluate: filterFunctionSearch},
+		{Name: "value", Parameters: []FilterFunctionType{FilterFunctionTypeNodes}, Result: FilterFunctionTypeValue, Evaluate: filterFunctionValue},
+	} {
+		filterFunctions[function.Name] = function
+	}
+}
+
+/*
+RegisterFilterFunction adds a function extension that can be used in filter selectors of paths parsed afterward.
+
+The name must start with a lowercase letter followed by lowercase letters, digits, or underscores and must not already be registered.
+The standard functions `length`, `count`, `match`, `search`, and `value` are registered by default.
+
+Example:
+
+	err := path.RegisterFilterFunction(&path.FilterFunction{
+		Name:       "is_even",
+		Parameters: []path.FilterFunctionType{path.FilterFunctionTypeValue},
+		Result:     path.FilterFunctionTypeLogical,
+		Evaluate: func(arguments []path.FilterFunctionValue) path.FilterFunctionValue {
+			v := arguments[0].Value
+			return path.FilterFunctionValue{Type: path.FilterFunctionTypeLogical, Logical: v.CanInt() && v.Int()%2 == 0}
+		},
+	})
+	segments := path.JSONPath("$.numbers[?is_even(@)]").Parse()
+*/
+func RegisterFilterFunction(function *FilterFunction) error {
+	const FunctionName = "RegisterFilterFunction"
+
+	if function == nil || function.Evaluate == nil {
+		return NewError().WithFunctionName(FunctionName).WithMessage("function or its Evaluate is nil").WithNestedError(ErrFilterFunctionError)
+	}
+
+	if !filterFunctionNameRegex.MatchString(function.Name) {
+		return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("invalid function name %q", function.Name)).WithNestedError(ErrFilterFunctionError)
+	}
+
+	for _, parameter := range append([]FilterFunctionType{function.Result}, function.Parameters...) {
+		if parameter.String() == "" {
+			return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("function %s has an invalid type %d", function.Name, parameter)).WithNestedError(ErrFilterFunctionError)
+		}
+	}
+
+	filterFunctionsMutex.Lock()
+	defer filterFunctionsMutex.Unlock()
+
+	if _, ok := filterFunctions[function.Name]; ok {
+		return NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("function %s already registered", function.Name)).WithNestedError(ErrFilterFunctionError)
+	}
+
+	filterFunctions[function.Name] = function
+	return nil
+}
+
+// GetFilterFunction returns the registered function extension with name.
+func GetFilterFunction(name string) (*FilterFunction, bool) {
+	filterFunctionsMutex.RLock()
+	defer filterFunctionsMutex.RUnlock()
+
+	function, ok := filterFunctions[name]
+	return function, ok
+}
+
+// filterFunctionIndirect unwraps pointers and interfaces. Returns an invalid value if a nil is encountered.
+func filterFunctionIndirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// filterFunctionNothing is the result of a function with FilterFunctionTypeValue that has no value.
+var filterFunctionNothing = FilterFunctionValue{Type: FilterFunctionTypeValue, IsNothing: true}
+
+/*
+filterFunctionLength implements `length(ValueType) ValueType`.
+
+Returns the number of characters in a string, the number of elements in an array/slice, the number of entries in a map, or the number of fields in a struct
+that can be addressed by a path with FilterFunctionValue.FieldNameStrategy.
+*/
+func filterFunctionLength(arguments []FilterFunctionValue) FilterFunctionValue {
+	if arguments[0].IsNothing {
+		return filterFunctionNothing
+	}
+
+	value := filterFunctionIndirect(arguments[0].Value)
+	if !value.IsValid() {
+		return filterFunctionNothing
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return FilterFunctionValue{Type: FilterFunctionTypeValue, Value: reflect.ValueOf(utf8.RuneCountInString(value.String()))}
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return FilterFunctionValue{Type: FilterFunctionTypeValue, Value: reflect.ValueOf(value.Len())}
+	case reflect.Struct:
+		return FilterFunctionValue{Type: FilterFunctionTypeValue, Value: reflect.ValueOf(len(arguments[0].FieldNameStrategy.Fields(value.Type())))}
+	default:
+		return filterFunctionNothing
+	}
+}
+
+// filterFunctionCount implements `count(NodesType) ValueType`.
+func filterFunctionCount(arguments []FilterFunctionValue) FilterFunctionValue {
+	return FilterFunctionValue{Type: FilterFunctionTypeValue, Value: reflect.ValueOf(len(arguments[0].Nodes))}
+}
+
+// filterFunctionValue implements `value(NodesType) ValueType`. Returns Nothing unless exactly one node was selected.
+func filterFunctionValue(arguments []FilterFunctionValue) FilterFunctionValue {
+	if len(arguments[0].Nodes) != 1 {
+		return filterFunctionNothing
+	}
+	return FilterFunctionValue{Type: FilterFunctionTypeValue, Value: arguments[0].Nodes[0]}
+}
+
+// filterFunctionMatch implements `match(ValueType, ValueType) LogicalType`. True if the whole string matches the regular expression.
+func filterFunctionMatch(arguments []FilterFunctionValue) FilterFunctionValue {
+	return filterFunctionRegexp(arguments, true)
+}
+
+// filterFunctionSearch implements `search(ValueType, ValueType) LogicalType`. True if a substring matches the regular expression.
+func filterFunctionSearch(arguments []FilterFunctionValue) FilterFunctionValue {
+	return filterFunctionRegexp(arguments, false)
+}
+
+func filterFunctionRegexp(arguments []FilterFunctionValue, fullMatch bool) FilterFunctionValue {
+	result := FilterFunctionValue{Type: FilterFunctionTypeLogical}
+
+	str := filterFunctionIndirect(arguments[0].Value)
+	pattern := filterFunctionIndirect(arguments[1].Value)
+	if arguments[0].IsNothing || arguments[1].IsNothing || !str.IsValid() || !pattern.IsValid() || str.Kind() != reflect.String || pattern.Kind() != reflect.String {
+		return result
+	}
+
+	if regex, ok := compileIRegexp(pattern.String(), fullMatch); ok {
+		result.Logical = regex.MatchString(str.String())
+	}
+	return result
+}
+
+type iRegexpCacheKey struct {
+	pattern   string
+	fullMatch bool
+}
+
+// iRegexpCacheCapacity is the number of compiled patterns kept in iRegexpCache.
+const iRegexpCacheCapacity = 256
+
+// iRegexpCacheEntry is a compiled pattern in iRegexpCache. A nil regex marks an invalid pattern.
+type iRegexpCacheEntry struct {
+	key   iRegexpCacheKey
+	regex *regexp.Regexp
+}
+
+// iRegexpCache is a least recently used cache of compiled patterns so that patterns built at runtime do not grow it without bound.
+var iRegexpCache = struct {
+	mutex   sync.Mutex
+	entries map[iRegexpCacheKey]*list.Element
+	// order holds *iRegexpCacheEntry with the most recently used at the front.
+	order *list.List
+}{
+	entries: make(map[iRegexpCacheKey]*list.Element, iRegexpCacheCapacity),
+	order:   list.New(),
+}
+
+// loadIRegexp returns the cached compiled pattern for key and true if it is in iRegexpCache.
+func loadIRegexp(key iRegexpCacheKey) (*regexp.Regexp, bool) {
+	iRegexpCache.mutex.Lock()
+	defer iRegexpCache.mutex.Unlock()
+
+	element, ok := iRegexpCache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	iRegexpCache.order.MoveToFront(element)
+	return element.Value.(*iRegexpCacheEntry).regex, true
+}
+
+// storeIRegexp adds regex to iRegexpCache and evicts the least recently used pattern when the cache is full.
+func storeIRegexp(key iRegexpCacheKey, regex *regexp.Regexp) {
+	iRegexpCache.mutex.Lock()
+	defer iRegexpCache.mutex.Unlock()
+
+	if element, ok := iRegexpCache.entries[key]; ok {
+		iRegexpCache.order.MoveToFront(element)
+		return
+	}
+
+	iRegexpCache.entries[key] = iRegexpCache.order.PushFront(&iRegexpCacheEntry{key: key, regex: regex})
+	if iRegexpCache.order.Len() > iRegexpCacheCapacity {
+		oldest := iRegexpCache.order.Back()
+		iRegexpCache.order.Remove(oldest)
+		delete(iRegexpCache.entries, oldest.Value.(*iRegexpCacheEntry).key)
+	}
+}
+
+/*
+compileIRegexp compiles an RFC 9485 I-Regexp pattern.
+
+The pattern is translated to RE2 syntax by replacing `.` outside character classes with `[^\n\r]`.
+If fullMatch is true, the pattern is anchored at both ends.
+*/
+func compileIRegexp(pattern string, fullMatch bool) (*regexp.Regexp, bool) {
+	key := iRegexpCacheKey{pattern: pattern, fullMatch: fullMatch}
+	if regex, ok := loadIRegexp(key); ok {
+		return regex, regex != nil
+	}
+
+	var builder strings.Builder
+	inCharacterClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			builder.WriteByte(c)
+			i++
+			builder.WriteByte(pattern[i])
+		case c == '[':
+			inCharacterClass = true
+			builder.WriteByte(c)
+		case c == ']':
+			inCharacterClass = false
+			builder.WriteByte(c)
+		case c == '.' && !inCharacterClass:
+			builder.WriteString(`[^\n\r]`)
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	translated := builder.String()
+	if fullMatch {
+		translated = `\A(?:` + translated + `)\z`
+	}
+
+	regex, err := regexp.Compile(translated)
+	if err != nil {
+		regex = nil
+	}
+	storeIRegexp(key, regex)
+	return regex, regex != nil
+}
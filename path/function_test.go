@@ -0,0 +1,139 @@
+package path
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+)
+
+func TestPath_RegisterFilterFunction(t *testing.T) {
+	isEven := &FilterFunction{
+		Name:       "test_is_even",
+		Parameters: []FilterFunctionType{FilterFunctionTypeValue},
+		Result:     FilterFunctionTypeLogical,
+		Evaluate: func(arguments []FilterFunctionValue) FilterFunctionValue {
+			return FilterFunctionValue{Type: FilterFunctionTypeLogical, Logical: arguments[0].Value.CanInt() && arguments[0].Value.Int()%2 == 0}
+		},
+	}
+
+	if _, ok := GetFilterFunction(isEven.Name); !ok {
+		if err := RegisterFilterFunction(isEven); err != nil {
+			t.Fatal("expected ok, got err=", err)
+		}
+	}
+
+	if _, err := JSONPath("$[?test_is_even(@.a)]").ParseStrict(); err != nil {
+		t.Error("expected registered function to parse, got err=", err)
+	}
+
+	for _, function := range []*FilterFunction{
+		nil,
+		{Name: "length", Parameters: isEven.Parameters, Result: isEven.Result, Evaluate: isEven.Evaluate},
+		{Name: "Upper", Parameters: isEven.Parameters, Result: isEven.Result, Evaluate: isEven.Evaluate},
+		{Name: "no_evaluate", Parameters: isEven.Parameters, Result: isEven.Result},
+		{Name: "bad_result", Parameters: isEven.Parameters, Evaluate: isEven.Evaluate},
+	} {
+		if err := RegisterFilterFunction(function); !errors.Is(err, ErrFilterFunctionError) {
+			t.Error("expected ErrFilterFunctionError, got err=", err)
+		}
+	}
+}
+
+type embeddedFields struct {
+	A, B string
+}
+
+func TestPath_StandardFilterFunctions(t *testing.T) {
+	value := func(v any) FilterFunctionValue {
+		return FilterFunctionValue{Type: FilterFunctionTypeValue, Value: reflect.ValueOf(v)}
+	}
+	nodes := func(v ...any) FilterFunctionValue {
+		result := FilterFunctionValue{Type: FilterFunctionTypeNodes, Nodes: make([]reflect.Value, 0)}
+		for _, node := range v {
+			result.Nodes = append(result.Nodes, reflect.ValueOf(node))
+		}
+		return result
+	}
+	roles := []string{"a", "b"}
+
+	for _, testData := range []struct {
+		Function  string
+		Arguments []FilterFunctionValue
+		Expected  any
+	}{
+		{Function: "length", Arguments: []FilterFunctionValue{value("héllo")}, Expected: 5},
+		{Function: "length", Arguments: []FilterFunctionValue{value([3]int{})}, Expected: 3},
+		{Function: "length", Arguments: []FilterFunctionValue{value(&roles)}, Expected: 2},
+		{Function: "length", Arguments: []FilterFunctionValue{value(map[int]string{1: "a"})}, Expected: 1},
+		{Function: "length", Arguments: []FilterFunctionValue{value(struct {
+			A, B string
+			c    string
+		}{})}, Expected: 2},
+		{Function: "length", Arguments: []FilterFunctionValue{{Type: FilterFunctionTypeValue, Value: reflect.ValueOf(struct {
+			Name     string `json:"name"`
+			Password string `json:"-"`
+		}{}), FieldNameStrategy: core.FieldNameJSONTag}}, Expected: 1},
+		{Function: "length", Arguments: []FilterFunctionValue{value(struct {
+			embeddedFields
+			C string
+		}{})}, Expected: 3},
+		{Function: "length", Arguments: []FilterFunctionValue{value(1)}, Expected: nil},
+		{Function: "count", Arguments: []FilterFunctionValue{nodes(1, "a", nil)}, Expected: 3},
+		{Function: "value", Arguments: []FilterFunctionValue{nodes("a")}, Expected: "a"},
+		{Function: "value", Arguments: []FilterFunctionValue{nodes("a", "b")}, Expected: nil},
+		{Function: "match", Arguments: []FilterFunctionValue{value("AB-12"), value("AB-[0-9]+")}, Expected: true},
+		{Function: "match", Arguments: []FilterFunctionValue{value("xAB-12"), value("AB-[0-9]+")}, Expected: false},
+		{Function: "match", Arguments: []FilterFunctionValue{value("a\r"), value("a.")}, Expected: false},
+		{Function: "match", Arguments: []FilterFunctionValue{value("a"), value("(")}, Expected: false},
+		{Function: "search", Arguments: []FilterFunctionValue{value("xAB-12y"), value("AB-[0-9]+")}, Expected: true},
+		{Function: "search", Arguments: []FilterFunctionValue{value(12), value("1")}, Expected: false},
+	} {
+		function, ok := GetFilterFunction(testData.Function)
+		if !ok {
+			t.Fatal("expected standard function", testData.Function, "to be registered")
+		}
+
+		var result any
+		switch res := function.Evaluate(testData.Arguments); res.Type {
+		case FilterFunctionTypeLogical:
+			result = res.Logical
+		case FilterFunctionTypeValue:
+			if !res.IsNothing {
+				result = res.Value.Interface()
+			}
+		}
+
+		if !reflect.DeepEqual(result, testData.Expected) {
+			t.Error(
+				"function=", testData.Function, "\n",
+				"expected=", testData.Expected, "\n",
+				"got=", result,
+			)
+		}
+	}
+}
+
+func TestPath_IRegexpCacheBounded(t *testing.T) {
+	for i := 0; i < iRegexpCacheCapacity+10; i++ {
+		if _, ok := compileIRegexp(fmt.Sprintf("a%d", i), true); !ok {
+			t.Fatal("expected pattern to compile")
+		}
+	}
+
+	iRegexpCache.mutex.Lock()
+	noOfEntries := len(iRegexpCache.entries)
+	iRegexpCache.mutex.Unlock()
+	if noOfEntries != iRegexpCacheCapacity {
+		t.Error("expected cache to hold", iRegexpCacheCapacity, "patterns, got=", noOfEntries)
+	}
+
+	if _, ok := loadIRegexp(iRegexpCacheKey{pattern: "a0", fullMatch: true}); ok {
+		t.Error("expected least recently used pattern to be evicted")
+	}
+	if regex, ok := loadIRegexp(iRegexpCacheKey{pattern: fmt.Sprintf("a%d", iRegexpCacheCapacity+9), fullMatch: true}); !ok || !regex.MatchString(fmt.Sprintf("a%d", iRegexpCacheCapacity+9)) {
+		t.Error("expected most recently used pattern to be cached")
+	}
+}
@@ -0,0 +1,187 @@
+package path
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rogonion/go-json/core"
+)
+
+/*
+JSONPointer is an alias for a string intended to represent an RFC 6901 JSON Pointer.
+Example: "/store/book/0/title"
+
+The empty string refers to the whole document.
+*/
+type JSONPointer string
+
+const (
+	JsonPointerSeparator  string = "/"
+	JsonPointerEscapeChar byte   = '~'
+)
+
+/*
+Parse converts the JSON Pointer into a RecursiveDescentSegment that starts with the root `$`.
+
+Each reference token is unescaped (`~1` becomes `/` and `~0` becomes `~`) and becomes a key.
+Since a JSON Pointer does not distinguish between object members and array elements, a token that is an array index (e.g., `0` or `12` but not `01`)
+sets both IsKey and IsIndex. The one that is used depends on the collection the segment is applied to. Likewise, the token `-` sets both IsKey and IsIndexEnd.
+
+Example:
+
+	segment, err := path.JSONPointer("/store/book/0/title").Parse()
+	segment.NormalizedString() // $['store']['book'][0]['title']
+*/
+func (n JSONPointer) Parse() (RecursiveDescentSegment, error) {
+	const FunctionName = "Parse"
+
+	segment := RecursiveDescentSegment{{Key: JsonpathKeyRoot, IsKeyRoot: true}}
+	if n == "" {
+		return segment, nil
+	}
+
+	if !strings.HasPrefix(string(n), JsonPointerSeparator) {
+		return nil, NewError().WithFunctionName(FunctionName).WithMessage("json pointer must be empty or start with '/'").WithNestedError(ErrJSONPointerError).WithData(core.JsonObject{"JSONPointer": n})
+	}
+
+	for _, referenceToken := range strings.Split(string(n)[1:], JsonPointerSeparator) {
+		key, err := unescapeJSONPointerReferenceToken(referenceToken)
+		if err != nil {
+			return nil, NewError().WithFunctionName(FunctionName).WithMessage(err.Error()).WithNestedError(ErrJSONPointerError).WithData(core.JsonObject{"JSONPointer": n, "ReferenceToken": referenceToken})
+		}
+
+		collectionMemberSegment := &CollectionMemberSegment{Key: key, IsKey: true}
+		if key == JsonpathIndexEnd {
+			collectionMemberSegment.IsIndexEnd = true
+		} else if isJSONPointerArrayIndex(key) {
+			if index, err := strconv.Atoi(key); err == nil {
+				collectionMemberSegment.Index = index
+				collectionMemberSegment.IsIndex = true
+			}
+		}
+		segment = append(segment, collectionMemberSegment)
+	}
+
+	return segment, nil
+}
+
+/*
+JSONPath converts the JSON Pointer into its normalized JSONPath e.g., `/store/book/0` becomes `$['store']['book'][0]`.
+
+Reference tokens that are array indexes become index selectors. Pass the JSON Pointer to the Object methods directly (JSONPath.Parse accepts it)
+to also match map keys such as "0".
+*/
+func (n JSONPointer) JSONPath() (JSONPath, error) {
+	segment, err := n.Parse()
+	if err != nil {
+		return "", err
+	}
+	return JSONPath(segment.NormalizedString()), nil
+}
+
+/*
+JSONPointer converts a segment made up of the root, keys, and non-negative indexes into a JSON Pointer.
+
+Returns an error if the segment is not singular i.e., it contains wildcards, slices, unions, filters, or negative indexes.
+*/
+func (n RecursiveDescentSegment) JSONPointer() (JSONPointer, error) {
+	const FunctionName = "JSONPointer"
+
+	var builder strings.Builder
+	for _, s := range n {
+		switch {
+		case s == nil:
+			continue
+		case s.IsKeyRoot:
+			continue
+		case s.IsKey:
+			builder.WriteString(JsonPointerSeparator)
+			builder.WriteString(escapeJSONPointerReferenceToken(s.Key))
+		case s.IsIndex && s.Index >= 0:
+			builder.WriteString(JsonPointerSeparator)
+			builder.WriteString(strconv.Itoa(s.Index))
+		case s.IsIndexEnd:
+			builder.WriteString(JsonPointerSeparator)
+			builder.WriteString(JsonpathIndexEnd)
+		default:
+			return "", NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("path segment %s cannot be converted to a json pointer as it is not singular", s)).WithNestedError(ErrJSONPointerError).WithData(core.JsonObject{"Path": n.String()})
+		}
+	}
+	return JSONPointer(builder.String()), nil
+}
+
+/*
+JSONPointer converts a singular JSONPath e.g., `$.store.book[0]` into a JSON Pointer e.g., `/store/book/0`.
+
+Returns an error if the path cannot be parsed or if it is not singular i.e., it contains recursive descent (`..`), wildcards, slices, unions, filters, or negative indexes.
+*/
+func (jsonPath JSONPath) JSONPointer() (JSONPointer, error) {
+	const FunctionName = "JSONPointer"
+
+	if strings.HasPrefix(string(jsonPath), JsonPointerSeparator) {
+		if _, err := JSONPointer(jsonPath).Parse(); err != nil {
+			return "", err
+		}
+		return JSONPointer(jsonPath), nil
+	}
+
+	recursiveDescentSegments, err := jsonPath.ParseStrict()
+	if err != nil {
+		return "", err
+	}
+
+	if len(recursiveDescentSegments) != 1 {
+		return "", NewError().WithFunctionName(FunctionName).WithMessage("path with recursive descent cannot be converted to a json pointer as it is not singular").WithNestedError(ErrJSONPointerError).WithData(core.JsonObject{"Path": jsonPath})
+	}
+
+	return recursiveDescentSegments[0].JSONPointer()
+}
+
+// escapeJSONPointerReferenceToken replaces `~` with `~0` and `/` with `~1`.
+func escapeJSONPointerReferenceToken(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// unescapeJSONPointerReferenceToken replaces `~1` with `/` and `~0` with `~`. Any other `~` sequence is an error.
+func unescapeJSONPointerReferenceToken(referenceToken string) (string, error) {
+	if strings.IndexByte(referenceToken, JsonPointerEscapeChar) < 0 {
+		return referenceToken, nil
+	}
+
+	var builder strings.Builder
+	for i := 0; i < len(referenceToken); i++ {
+		if referenceToken[i] != JsonPointerEscapeChar {
+			builder.WriteByte(referenceToken[i])
+			continue
+		}
+
+		if i+1 >= len(referenceToken) {
+			return "", fmt.Errorf("incomplete escape sequence in reference token %q", referenceToken)
+		}
+
+		i++
+		switch referenceToken[i] {
+		case '0':
+			builder.WriteByte('~')
+		case '1':
+			builder.WriteByte('/')
+		default:
+			return "", fmt.Errorf("invalid escape sequence '~%c' in reference token %q", referenceToken[i], referenceToken)
+		}
+	}
+	return builder.String(), nil
+}
+
+// isJSONPointerArrayIndex returns true if referenceToken matches the RFC 6901 array-index rule i.e., `0` or a number without leading zeros.
+func isJSONPointerArrayIndex(referenceToken string) bool {
+	if referenceToken == "" || (len(referenceToken) > 1 && referenceToken[0] == '0') {
+		return false
+	}
+	for i := 0; i < len(referenceToken); i++ {
+		if referenceToken[i] < '0' || referenceToken[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
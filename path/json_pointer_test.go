@@ -0,0 +1,135 @@
+package path
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPath_JSONPointerParse(t *testing.T) {
+	for _, testData := range []struct {
+		JSONPointer     JSONPointer
+		ExpectedSegment RecursiveDescentSegment
+		ExpectedOk      bool
+	}{
+		{
+			JSONPointer:     "",
+			ExpectedSegment: RecursiveDescentSegment{{Key: "$", IsKeyRoot: true}},
+			ExpectedOk:      true,
+		},
+		{
+			JSONPointer: "/data/items/0/name",
+			ExpectedSegment: RecursiveDescentSegment{
+				{Key: "$", IsKeyRoot: true},
+				{Key: "data", IsKey: true},
+				{Key: "items", IsKey: true},
+				{Key: "0", IsKey: true, Index: 0, IsIndex: true},
+				{Key: "name", IsKey: true},
+			},
+			ExpectedOk: true,
+		},
+		{
+			JSONPointer: "/a~1b/m~0n/~01/01/",
+			ExpectedSegment: RecursiveDescentSegment{
+				{Key: "$", IsKeyRoot: true},
+				{Key: "a/b", IsKey: true},
+				{Key: "m~n", IsKey: true},
+				{Key: "~1", IsKey: true},
+				{Key: "01", IsKey: true},
+				{Key: "", IsKey: true},
+			},
+			ExpectedOk: true,
+		},
+		{
+			JSONPointer: "/items/-",
+			ExpectedSegment: RecursiveDescentSegment{
+				{Key: "$", IsKeyRoot: true},
+				{Key: "items", IsKey: true},
+				{Key: "-", IsKey: true, IsIndexEnd: true},
+			},
+			ExpectedOk: true,
+		},
+		{JSONPointer: "data/items", ExpectedOk: false},
+		{JSONPointer: "/a~2b", ExpectedOk: false},
+		{JSONPointer: "/a~", ExpectedOk: false},
+	} {
+		segment, err := testData.JSONPointer.Parse()
+		if !testData.ExpectedOk {
+			if !errors.Is(err, ErrJSONPointerError) {
+				t.Error("json pointer=", testData.JSONPointer, "\n", "expected ErrJSONPointerError, got err=", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error("json pointer=", testData.JSONPointer, "\n", "expected no error, got err=", err)
+			continue
+		}
+
+		if !reflect.DeepEqual(segment, testData.ExpectedSegment) {
+			t.Error(
+				"json pointer=", testData.JSONPointer, "\n",
+				"expected=", testData.ExpectedSegment, "\n",
+				"got=", segment,
+			)
+		}
+
+		jsonPointer, err := segment.JSONPointer()
+		if err != nil || jsonPointer != testData.JSONPointer {
+			t.Error("expected roundtrip=", testData.JSONPointer, "\n", "got=", jsonPointer, "err=", err)
+		}
+	}
+}
+
+func TestPath_JSONPathToJSONPointer(t *testing.T) {
+	for _, testData := range []struct {
+		JSONPath            JSONPath
+		ExpectedJSONPointer JSONPointer
+		ExpectedOk          bool
+	}{
+		{JSONPath: "$", ExpectedJSONPointer: "", ExpectedOk: true},
+		{JSONPath: "$.store.book[0].title", ExpectedJSONPointer: "/store/book/0/title", ExpectedOk: true},
+		{JSONPath: `$['a/b']['m~n']`, ExpectedJSONPointer: "/a~1b/m~0n", ExpectedOk: true},
+		{JSONPath: "/store/book/0", ExpectedJSONPointer: "/store/book/0", ExpectedOk: true},
+		{JSONPath: "$.store.book[-]", ExpectedJSONPointer: "/store/book/-", ExpectedOk: true},
+		{JSONPath: "$..title", ExpectedOk: false},
+		{JSONPath: "$.store.book[*]", ExpectedOk: false},
+		{JSONPath: "$.store.book[0:2]", ExpectedOk: false},
+		{JSONPath: "$.store.book[0,1]", ExpectedOk: false},
+		{JSONPath: "$.store.book[?@.price < 10]", ExpectedOk: false},
+		{JSONPath: "$.store.book[-1]", ExpectedOk: false},
+		{JSONPath: "$.store[", ExpectedOk: false},
+		{JSONPath: "/store/~2", ExpectedOk: false},
+	} {
+		jsonPointer, err := testData.JSONPath.JSONPointer()
+		if !testData.ExpectedOk {
+			if err == nil {
+				t.Error("path=", testData.JSONPath, "\n", "expected error, got json pointer=", jsonPointer)
+			}
+			continue
+		}
+
+		if err != nil || jsonPointer != testData.ExpectedJSONPointer {
+			t.Error(
+				"path=", testData.JSONPath, "\n",
+				"expected=", testData.ExpectedJSONPointer, "\n",
+				"got=", jsonPointer, "err=", err,
+			)
+		}
+	}
+}
+
+func TestPath_JSONPointerToJSONPath(t *testing.T) {
+	jsonPath, err := JSONPointer("/store/book/0/a~1b").JSONPath()
+	if err != nil {
+		t.Fatal("expected no error, got err=", err)
+	}
+
+	if expected := JSONPath("$['store']['book'][0]['a/b']"); jsonPath != expected {
+		t.Error("expected=", expected, "\n", "got=", jsonPath)
+	}
+
+	if segments := JSONPath("/store/~2").Parse(); len(segments) != 0 {
+		t.Error("expected invalid json pointer to parse to empty segments, got=", segments)
+	}
+}
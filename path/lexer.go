@@ -0,0 +1,437 @@
+package path
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rogonion/go-json/core"
+)
+
+// tokenKind identifies the type of token produced by the lexer.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenRoot
+	tokenCurrent
+	tokenDot
+	tokenDoubleDot
+	tokenLeftBracket
+	tokenRightBracket
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenColon
+	tokenWildcard
+	tokenIndexEnd
+	tokenQuestion
+	tokenName
+	tokenString
+	tokenInteger
+	tokenNumber
+	tokenOr
+	tokenAnd
+	tokenNot
+	tokenEqual
+	tokenNotEqual
+	tokenLess
+	tokenLessOrEqual
+	tokenGreater
+	tokenGreaterOrEqual
+)
+
+// String returns a readable representation of the token kind for use in error messages.
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of path"
+	case tokenRoot:
+		return "'$'"
+	case tokenCurrent:
+		return "'@'"
+	case tokenDot:
+		return "'.'"
+	case tokenDoubleDot:
+		return "'..'"
+	case tokenLeftBracket:
+		return "'['"
+	case tokenRightBracket:
+		return "']'"
+	case tokenLeftParen:
+		return "'('"
+	case tokenRightParen:
+		return "')'"
+	case tokenComma:
+		return "','"
+	case tokenColon:
+		return "':'"
+	case tokenWildcard:
+		return "'*'"
+	case tokenIndexEnd:
+		return "'-'"
+	case tokenQuestion:
+		return "'?'"
+	case tokenName:
+		return "name"
+	case tokenString:
+		return "string literal"
+	case tokenInteger:
+		return "integer"
+	case tokenNumber:
+		return "number"
+	case tokenOr:
+		return "'||'"
+	case tokenAnd:
+		return "'&&'"
+	case tokenNot:
+		return "'!'"
+	case tokenEqual:
+		return "'=='"
+	case tokenNotEqual:
+		return "'!='"
+	case tokenLess:
+		return "'<'"
+	case tokenLessOrEqual:
+		return "'<='"
+	case tokenGreater:
+		return "'>'"
+	case tokenGreaterOrEqual:
+		return "'>='"
+	default:
+		return "unknown token"
+	}
+}
+
+/*
+token is a single lexical unit of a JSONPath query.
+
+For tokenString, value holds the decoded (unescaped) string. For every other kind it holds the raw text.
+*/
+type token struct {
+	kind  tokenKind
+	value string
+	// Byte offset of the first character of the token in the JSONPath.
+	offset int
+	// True if blank space (space, tab, line feed or carriage return) preceded the token.
+	spaceBefore bool
+}
+
+/*
+lexer converts a JSONPath string into tokens following the lexical rules of RFC 9535.
+
+Tokens are produced on demand with next and peek so that the parser can enforce the places where blank space is not allowed.
+*/
+type lexer struct {
+	input  string
+	offset int
+	peeked *token
+}
+
+func newLexer(input string) *lexer {
+	return &lexer{input: input}
+}
+
+// peek returns the next token without consuming it.
+func (l *lexer) peek() (token, error) {
+	if l.peeked != nil {
+		return *l.peeked, nil
+	}
+	t, err := l.scan()
+	if err != nil {
+		return t, err
+	}
+	l.peeked = &t
+	return t, nil
+}
+
+// next consumes and returns the next token.
+func (l *lexer) next() (token, error) {
+	if l.peeked != nil {
+		t := *l.peeked
+		l.peeked = nil
+		return t, nil
+	}
+	return l.scan()
+}
+
+// error builds a syntax error pointing at offset.
+func (l *lexer) error(offset int, message string) error {
+	return newSyntaxError(JSONPath(l.input), offset, message)
+}
+
+func (l *lexer) scan() (token, error) {
+	spaceBefore := false
+	for l.offset < len(l.input) && isBlankSpace(l.input[l.offset]) {
+		l.offset++
+		spaceBefore = true
+	}
+
+	start := l.offset
+	if l.offset >= len(l.input) {
+		return token{kind: tokenEOF, offset: start, spaceBefore: spaceBefore}, nil
+	}
+
+	single := func(kind tokenKind) (token, error) {
+		l.offset++
+		return token{kind: kind, value: l.input[start:l.offset], offset: start, spaceBefore: spaceBefore}, nil
+	}
+	// double scans a two character operator whose second character is second, falling back to kind for the first character alone.
+	double := func(second byte, doubleKind tokenKind, kind tokenKind) (token, error) {
+		if l.offset+1 < len(l.input) && l.input[l.offset+1] == second {
+			l.offset += 2
+			return token{kind: doubleKind, value: l.input[start:l.offset], offset: start, spaceBefore: spaceBefore}, nil
+		}
+		if kind == tokenEOF {
+			return token{}, l.error(start, fmt.Sprintf("unexpected character %q", l.input[start]))
+		}
+		return single(kind)
+	}
+
+	c := l.input[l.offset]
+	switch {
+	case c == '$':
+		return single(tokenRoot)
+	case c == '@':
+		return single(tokenCurrent)
+	case c == '.':
+		if l.offset+1 < len(l.input) && l.input[l.offset+1] == '.' {
+			l.offset += 2
+			return token{kind: tokenDoubleDot, value: "..", offset: start, spaceBefore: spaceBefore}, nil
+		}
+		return single(tokenDot)
+	case c == '[':
+		return single(tokenLeftBracket)
+	case c == ']':
+		return single(tokenRightBracket)
+	case c == '(':
+		return single(tokenLeftParen)
+	case c == ')':
+		return single(tokenRightParen)
+	case c == ',':
+		return single(tokenComma)
+	case c == ':':
+		return single(tokenColon)
+	case c == '*':
+		return single(tokenWildcard)
+	case c == '?':
+		return single(tokenQuestion)
+	case c == '|':
+		return double('|', tokenOr, tokenEOF)
+	case c == '&':
+		return double('&', tokenAnd, tokenEOF)
+	case c == '=':
+		return double('=', tokenEqual, tokenEOF)
+	case c == '!':
+		return double('=', tokenNotEqual, tokenNot)
+	case c == '<':
+		return double('=', tokenLessOrEqual, tokenLess)
+	case c == '>':
+		return double('=', tokenGreaterOrEqual, tokenGreater)
+	case c == '\'' || c == '"':
+		value, err := l.scanString(c)
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokenString, value: value, offset: start, spaceBefore: spaceBefore}, nil
+	case c == '-' && (l.offset+1 >= len(l.input) || !isDigit(l.input[l.offset+1])):
+		l.offset++
+		return token{kind: tokenIndexEnd, value: JsonpathIndexEnd, offset: start, spaceBefore: spaceBefore}, nil
+	case c == '-' || isDigit(c):
+		return l.scanNumber(spaceBefore)
+	}
+
+	if r, size := utf8.DecodeRuneInString(l.input[l.offset:]); isNameFirst(r) && !(r == utf8.RuneError && size == 1) {
+		l.offset += size
+		for l.offset < len(l.input) {
+			r, size = utf8.DecodeRuneInString(l.input[l.offset:])
+			if !isNameChar(r) || (r == utf8.RuneError && size == 1) {
+				break
+			}
+			l.offset += size
+		}
+		return token{kind: tokenName, value: l.input[start:l.offset], offset: start, spaceBefore: spaceBefore}, nil
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.input[l.offset:])
+	return token{}, l.error(start, fmt.Sprintf("unexpected character %q", r))
+}
+
+// scanNumber scans an integer or a number with optional fraction and exponent parts.
+func (l *lexer) scanNumber(spaceBefore bool) (token, error) {
+	start := l.offset
+	kind := tokenInteger
+
+	if l.input[l.offset] == '-' {
+		l.offset++
+	}
+	digitsStart := l.offset
+	for l.offset < len(l.input) && isDigit(l.input[l.offset]) {
+		l.offset++
+	}
+	if l.offset == digitsStart {
+		return token{}, l.error(start, "expected digit after '-'")
+	}
+	if l.offset-digitsStart > 1 && l.input[digitsStart] == '0' {
+		return token{}, l.error(digitsStart, "leading zeros are not allowed in numbers")
+	}
+
+	if l.offset < len(l.input) && l.input[l.offset] == '.' && l.offset+1 < len(l.input) && isDigit(l.input[l.offset+1]) {
+		kind = tokenNumber
+		l.offset++
+		for l.offset < len(l.input) && isDigit(l.input[l.offset]) {
+			l.offset++
+		}
+	}
+
+	if l.offset < len(l.input) && (l.input[l.offset] == 'e' || l.input[l.offset] == 'E') {
+		kind = tokenNumber
+		l.offset++
+		if l.offset < len(l.input) && (l.input[l.offset] == '+' || l.input[l.offset] == '-') {
+			l.offset++
+		}
+		exponentStart := l.offset
+		for l.offset < len(l.input) && isDigit(l.input[l.offset]) {
+			l.offset++
+		}
+		if l.offset == exponentStart {
+			return token{}, l.error(exponentStart, "expected digit in exponent")
+		}
+	}
+
+	return token{kind: kind, value: l.input[start:l.offset], offset: start, spaceBefore: spaceBefore}, nil
+}
+
+// scanString scans a single or double-quoted string literal and returns its decoded value.
+func (l *lexer) scanString(quote byte) (string, error) {
+	start := l.offset
+	l.offset++
+
+	var builder strings.Builder
+	for {
+		if l.offset >= len(l.input) {
+			return "", l.error(start, "unterminated string literal")
+		}
+
+		c := l.input[l.offset]
+		switch {
+		case c == quote:
+			l.offset++
+			return builder.String(), nil
+		case c == '\\':
+			if err := l.scanEscape(quote, &builder); err != nil {
+				return "", err
+			}
+		case c < 0x20:
+			return "", l.error(l.offset, "control characters must be escaped in string literals")
+		default:
+			r, size := utf8.DecodeRuneInString(l.input[l.offset:])
+			if r == utf8.RuneError && size == 1 {
+				return "", l.error(l.offset, "invalid UTF-8 in string literal")
+			}
+			builder.WriteRune(r)
+			l.offset += size
+		}
+	}
+}
+
+// scanEscape decodes an escape sequence starting at the current backslash.
+func (l *lexer) scanEscape(quote byte, builder *strings.Builder) error {
+	escapeStart := l.offset
+	l.offset++
+	if l.offset >= len(l.input) {
+		return l.error(escapeStart, "unterminated escape sequence")
+	}
+
+	c := l.input[l.offset]
+	l.offset++
+	switch c {
+	case 'b':
+		builder.WriteByte('\b')
+	case 'f':
+		builder.WriteByte('\f')
+	case 'n':
+		builder.WriteByte('\n')
+	case 'r':
+		builder.WriteByte('\r')
+	case 't':
+		builder.WriteByte('\t')
+	case '/':
+		builder.WriteByte('/')
+	case '\\':
+		builder.WriteByte('\\')
+	case 'u':
+		r, err := l.scanHexRune(escapeStart)
+		if err != nil {
+			return err
+		}
+		if r >= 0xD800 && r <= 0xDBFF {
+			if l.offset+1 >= len(l.input) || l.input[l.offset] != '\\' || l.input[l.offset+1] != 'u' {
+				return l.error(escapeStart, "high surrogate must be followed by a low surrogate")
+			}
+			lowStart := l.offset
+			l.offset += 2
+			low, err := l.scanHexRune(lowStart)
+			if err != nil {
+				return err
+			}
+			if low < 0xDC00 || low > 0xDFFF {
+				return l.error(lowStart, "invalid low surrogate")
+			}
+			r = 0x10000 + (r-0xD800)<<10 + (low - 0xDC00)
+		} else if r >= 0xDC00 && r <= 0xDFFF {
+			return l.error(escapeStart, "unexpected low surrogate")
+		}
+		builder.WriteRune(r)
+	default:
+		if c == quote {
+			builder.WriteByte(c)
+			return nil
+		}
+		return l.error(escapeStart, fmt.Sprintf("invalid escape sequence '\\%c'", c))
+	}
+	return nil
+}
+
+// scanHexRune reads the four hexadecimal digits of a \uXXXX escape.
+func (l *lexer) scanHexRune(escapeStart int) (rune, error) {
+	if l.offset+4 > len(l.input) {
+		return 0, l.error(escapeStart, "incomplete unicode escape sequence")
+	}
+	value, err := strconv.ParseUint(l.input[l.offset:l.offset+4], 16, 32)
+	if err != nil {
+		return 0, l.error(escapeStart, "invalid unicode escape sequence")
+	}
+	l.offset += 4
+	return rune(value), nil
+}
+
+// newSyntaxError creates an error of ErrJSONPathSyntaxError that records the offset at which parsing failed.
+func newSyntaxError(jsonPath JSONPath, offset int, message string) error {
+	return NewError().WithFunctionName("ParseStrict").WithMessage(fmt.Sprintf("%s at offset %d", message, offset)).
+		WithNestedError(ErrJSONPathSyntaxError).
+		WithData(core.JsonObject{"Path": string(jsonPath), "Offset": offset})
+}
+
+func isBlankSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// isNameFirst reports whether r can start a member-name-shorthand.
+func isNameFirst(r rune) bool {
+	return isAlpha(r) || r == '_' || (r >= 0x80 && r <= 0xD7FF) || (r >= 0xE000 && r <= 0x10FFFF)
+}
+
+// isNameChar reports whether r can appear after the first character of a member-name-shorthand.
+func isNameChar(r rune) bool {
+	return isNameFirst(r) || (r >= '0' && r <= '9')
+}
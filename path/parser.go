@@ -0,0 +1,677 @@
+package path
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// maxSafeInteger is the largest integer that can be used as an index or slice value (I-JSON range).
+	maxSafeInteger int64 = 1<<53 - 1
+)
+
+/*
+ParseStrict breaks down a JSONPath string into RecursiveDescentSegments following the RFC 9535 grammar.
+
+Unlike Parse, it never guesses: any part of the path that does not conform to the grammar is reported as an error of ErrJSONPathSyntaxError.
+The error is a core.Error whose Data contains the `Path` and the byte `Offset` at which parsing failed.
+
+As an extension, the end of a linear collection `-` from RFC 6902 is accepted as a selector on its own e.g., `$.items[-]`.
+
+Example:
+
+	segments, err := path.JSONPath("$.store.book[0]['title']").ParseStrict()
+*/
+func (jsonPath JSONPath) ParseStrict() (RecursiveDescentSegments, error) {
+	p := &parser{lexer: newLexer(string(jsonPath)), path: jsonPath}
+	return p.parseQuery()
+}
+
+/*
+parser is a recursive descent parser for JSONPath queries.
+
+It consumes tokens from lexer and builds the same RecursiveDescentSegments representation that Parse produces.
+*/
+type parser struct {
+	lexer *lexer
+	path  JSONPath
+}
+
+// error builds a syntax error pointing at offset.
+func (p *parser) error(offset int, message string) error {
+	return newSyntaxError(p.path, offset, message)
+}
+
+// unexpected builds a syntax error for a token that is not valid at the current position.
+func (p *parser) unexpected(t token, expected string) error {
+	if t.kind == tokenEOF {
+		return p.error(t.offset, fmt.Sprintf("unexpected end of path, expected %s", expected))
+	}
+	return p.error(t.offset, fmt.Sprintf("unexpected %s, expected %s", t.kind, expected))
+}
+
+// expect consumes the next token and checks that it is of kind.
+func (p *parser) expect(kind tokenKind) (token, error) {
+	t, err := p.lexer.next()
+	if err != nil {
+		return t, err
+	}
+	if t.kind != kind {
+		return t, p.unexpected(t, kind.String())
+	}
+	return t, nil
+}
+
+// parseQuery parses `jsonpath-query = root-identifier segments`.
+func (p *parser) parseQuery() (RecursiveDescentSegments, error) {
+	root, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	if root.kind != tokenRoot || root.spaceBefore {
+		return nil, p.unexpected(root, "root identifier '$'")
+	}
+
+	segments, err := p.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind != tokenEOF {
+		return nil, p.unexpected(t, "'.', '..' or '['")
+	}
+	if t.spaceBefore {
+		return nil, p.error(t.offset, "trailing blank space is not allowed")
+	}
+	return segments, nil
+}
+
+// parseSegments parses `segments = *(S segment)` following a root or current node identifier.
+func (p *parser) parseSegments() (RecursiveDescentSegments, error) {
+	segments := RecursiveDescentSegments{
+		{
+			{
+				Key:               JsonpathKeyRoot,
+				IsKeyRoot:         true,
+				ExpectLinear:      true,
+				ExpectAssociative: true,
+			},
+		},
+	}
+
+	for {
+		t, err := p.lexer.peek()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t.kind {
+		case tokenDot:
+			_, _ = p.lexer.next()
+			segment, err := p.parseDotMember()
+			if err != nil {
+				return nil, err
+			}
+			segments[len(segments)-1] = append(segments[len(segments)-1], segment)
+		case tokenDoubleDot:
+			_, _ = p.lexer.next()
+			segment, err := p.parseDescendantMember()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, RecursiveDescentSegment{segment})
+		case tokenLeftBracket:
+			segment, err := p.parseBracketedSelection()
+			if err != nil {
+				return nil, err
+			}
+			segments[len(segments)-1] = append(segments[len(segments)-1], segment)
+		default:
+			return segments, nil
+		}
+	}
+}
+
+// parseDotMember parses the wildcard-selector or member-name-shorthand that immediately follows '.'.
+func (p *parser) parseDotMember() (*CollectionMemberSegment, error) {
+	t, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.spaceBefore {
+		return nil, p.error(t.offset, "blank space is not allowed after '.'")
+	}
+
+	switch t.kind {
+	case tokenName:
+		return &CollectionMemberSegment{Key: t.value, IsKey: true, ExpectAssociative: true}, nil
+	case tokenWildcard:
+		return &CollectionMemberSegment{Key: JsonpathKeyIndexAll, IsKeyIndexAll: true, ExpectAssociative: true}, nil
+	default:
+		return nil, p.unexpected(t, "member name or '*'")
+	}
+}
+
+// parseDescendantMember parses the bracketed-selection, wildcard-selector or member-name-shorthand that immediately follows '..'.
+func (p *parser) parseDescendantMember() (*CollectionMemberSegment, error) {
+	t, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+	if t.spaceBefore {
+		return nil, p.error(t.offset, "blank space is not allowed after '..'")
+	}
+	if t.kind == tokenLeftBracket {
+		return p.parseBracketedSelection()
+	}
+	return p.parseDotMember()
+}
+
+// parseBracketedSelection parses `"[" S selector *(S "," S selector) S "]"`.
+func (p *parser) parseBracketedSelection() (*CollectionMemberSegment, error) {
+	if _, err := p.expect(tokenLeftBracket); err != nil {
+		return nil, err
+	}
+
+	selectors := make(RecursiveDescentSegment, 0, 1)
+	indexEndOffset := -1
+	for {
+		t, err := p.lexer.peek()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == tokenIndexEnd {
+			indexEndOffset = t.offset
+		}
+		selector, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+
+		t, err = p.lexer.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == tokenRightBracket {
+			break
+		}
+		if t.kind != tokenComma {
+			return nil, p.unexpected(t, "',' or ']'")
+		}
+	}
+
+	if len(selectors) == 1 {
+		return selectors[0], nil
+	}
+	if indexEndOffset >= 0 {
+		return nil, p.error(indexEndOffset, "'-' cannot be part of a union")
+	}
+
+	union := &CollectionMemberSegment{
+		UnionSelector:     make(RecursiveDescentSegment, 0, len(selectors)),
+		ExpectLinear:      true,
+		ExpectAssociative: true,
+	}
+	for _, selector := range selectors {
+		union.UnionSelector = append(union.UnionSelector, selector.asUnionMember())
+	}
+	return union, nil
+}
+
+// parseSelector parses a single selector inside brackets: name, wildcard, index, end of linear collection `-`, slice or filter.
+func (p *parser) parseSelector() (*CollectionMemberSegment, error) {
+	t, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.kind {
+	case tokenString:
+		_, _ = p.lexer.next()
+		return &CollectionMemberSegment{Key: t.value, IsKey: true, ExpectAssociative: true}, nil
+	case tokenWildcard:
+		_, _ = p.lexer.next()
+		return &CollectionMemberSegment{Key: JsonpathKeyIndexAll, IsKeyIndexAll: true, ExpectAssociative: true, ExpectLinear: true}, nil
+	case tokenInteger, tokenColon:
+		return p.parseIndexOrSlice()
+	case tokenIndexEnd:
+		_, _ = p.lexer.next()
+		return &CollectionMemberSegment{Key: JsonpathIndexEnd, IsIndexEnd: true, ExpectLinear: true}, nil
+	case tokenQuestion:
+		_, _ = p.lexer.next()
+		expression, err := p.parseLogicalOr()
+		if err != nil {
+			return nil, err
+		}
+		return &CollectionMemberSegment{FilterSelector: expression, ExpectLinear: true, ExpectAssociative: true}, nil
+	default:
+		return nil, p.unexpected(t, "selector")
+	}
+}
+
+// parseIndexOrSlice parses an index-selector or a slice-selector `[start] S ":" S [end] [S ":" [S step]]`.
+func (p *parser) parseIndexOrSlice() (*CollectionMemberSegment, error) {
+	linearCollectionSelector := new(LinearCollectionSelector)
+
+	t, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind == tokenInteger {
+		_, _ = p.lexer.next()
+		start, err := p.parseInteger(t)
+		if err != nil {
+			return nil, err
+		}
+
+		if next, err := p.lexer.peek(); err != nil {
+			return nil, err
+		} else if next.kind != tokenColon {
+			return &CollectionMemberSegment{Index: start, IsIndex: true, ExpectLinear: true}, nil
+		}
+		linearCollectionSelector.Start = start
+		linearCollectionSelector.IsStart = true
+	}
+
+	if _, err := p.expect(tokenColon); err != nil {
+		return nil, err
+	}
+
+	if t, err = p.lexer.peek(); err != nil {
+		return nil, err
+	}
+	if t.kind == tokenInteger {
+		_, _ = p.lexer.next()
+		end, err := p.parseInteger(t)
+		if err != nil {
+			return nil, err
+		}
+		linearCollectionSelector.End = end
+		linearCollectionSelector.IsEnd = true
+		if t, err = p.lexer.peek(); err != nil {
+			return nil, err
+		}
+	}
+
+	if t.kind == tokenColon {
+		_, _ = p.lexer.next()
+		if t, err = p.lexer.peek(); err != nil {
+			return nil, err
+		}
+		if t.kind == tokenInteger {
+			_, _ = p.lexer.next()
+			step, err := p.parseInteger(t)
+			if err != nil {
+				return nil, err
+			}
+			linearCollectionSelector.Step = step
+			linearCollectionSelector.IsStep = true
+		}
+	}
+
+	if !linearCollectionSelector.IsStart && !linearCollectionSelector.IsEnd && !linearCollectionSelector.IsStep {
+		return &CollectionMemberSegment{Key: JsonpathKeyIndexAll, IsKeyIndexAll: true, ExpectAssociative: true, ExpectLinear: true}, nil
+	}
+
+	return &CollectionMemberSegment{LinearCollectionSelector: linearCollectionSelector, ExpectLinear: true}, nil
+}
+
+// parseInteger converts an integer token to int, enforcing the I-JSON range.
+func (p *parser) parseInteger(t token) (int, error) {
+	if t.value == "-0" {
+		return 0, p.error(t.offset, "'-0' is not a valid integer")
+	}
+	value, err := strconv.ParseInt(t.value, 10, 64)
+	if err != nil || value > maxSafeInteger || value < -maxSafeInteger {
+		return 0, p.error(t.offset, fmt.Sprintf("integer %s out of range", t.value))
+	}
+	return int(value), nil
+}
+
+// asUnionMember strips the Expect hints from a selector that is part of a UnionSelector.
+func (n *CollectionMemberSegment) asUnionMember() *CollectionMemberSegment {
+	member := *n
+	member.ExpectLinear = false
+	member.ExpectAssociative = false
+	return &member
+}
+
+// parseLogicalOr parses `logical-or-expr = logical-and-expr *(S "||" S logical-and-expr)`.
+func (p *parser) parseLogicalOr() (*FilterExpression, error) {
+	return p.parseLogical(tokenOr, FilterOperatorOr, p.parseLogicalAnd)
+}
+
+// parseLogicalAnd parses `logical-and-expr = basic-expr *(S "&&" S basic-expr)`.
+func (p *parser) parseLogicalAnd() (*FilterExpression, error) {
+	return p.parseLogical(tokenAnd, FilterOperatorAnd, p.parseBasicExpression)
+}
+
+// parseLogical parses one or more operands separated by the operator token kind.
+func (p *parser) parseLogical(kind tokenKind, operator FilterOperator, parseOperand func() (*FilterExpression, error)) (*FilterExpression, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []*FilterExpression{operand}
+	for {
+		t, err := p.lexer.peek()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind != kind {
+			break
+		}
+		_, _ = p.lexer.next()
+
+		if operand, err = parseOperand(); err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &FilterExpression{Operator: operator, Operands: operands}, nil
+}
+
+// parseBasicExpression parses `basic-expr = paren-expr / comparison-expr / test-expr`.
+func (p *parser) parseBasicExpression() (*FilterExpression, error) {
+	t, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.kind {
+	case tokenNot:
+		_, _ = p.lexer.next()
+		if t, err = p.lexer.peek(); err != nil {
+			return nil, err
+		}
+		var operand *FilterExpression
+		switch t.kind {
+		case tokenLeftParen:
+			operand, err = p.parseParenExpression()
+		case tokenCurrent, tokenRoot:
+			operand, err = p.parseTestExpression()
+		case tokenName:
+			if operand, err = p.parseComparable(); err == nil {
+				err = p.checkTestable(operand, t.offset)
+			}
+		default:
+			return nil, p.unexpected(t, "'(', filter query, or function after '!'")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &FilterExpression{Operator: FilterOperatorNot, Operands: []*FilterExpression{operand}}, nil
+	case tokenLeftParen:
+		return p.parseParenExpression()
+	}
+
+	leftOffset := t.offset
+	left, err := p.parseComparable()
+	if err != nil {
+		return nil, err
+	}
+
+	if t, err = p.lexer.peek(); err != nil {
+		return nil, err
+	}
+	operator, isComparison := comparisonOperators[t.kind]
+	if !isComparison {
+		switch left.Operator {
+		case FilterOperatorQuery:
+			return &FilterExpression{Operator: FilterOperatorExists, Operands: []*FilterExpression{left}}, nil
+		case FilterOperatorFunction:
+			if err := p.checkTestable(left, leftOffset); err != nil {
+				return nil, err
+			}
+			return left, nil
+		}
+		return nil, p.unexpected(t, "comparison operator")
+	}
+	if err := p.checkComparable(left, leftOffset); err != nil {
+		return nil, err
+	}
+	_, _ = p.lexer.next()
+
+	rightToken, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.parseComparable()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkComparable(right, rightToken.offset); err != nil {
+		return nil, err
+	}
+
+	return &FilterExpression{Operator: operator, Operands: []*FilterExpression{left, right}}, nil
+}
+
+// comparisonOperators maps comparison tokens to their FilterOperator.
+var comparisonOperators = map[tokenKind]FilterOperator{
+	tokenEqual:          FilterOperatorEqual,
+	tokenNotEqual:       FilterOperatorNotEqual,
+	tokenLess:           FilterOperatorLess,
+	tokenLessOrEqual:    FilterOperatorLessOrEqual,
+	tokenGreater:        FilterOperatorGreater,
+	tokenGreaterOrEqual: FilterOperatorGreaterOrEqual,
+}
+
+// checkComparable ensures that a query used in a comparison is singular and that a function used in a comparison returns FilterFunctionTypeValue.
+func (p *parser) checkComparable(expression *FilterExpression, offset int) error {
+	if expression.Operator == FilterOperatorQuery && !expression.Query.IsSingular() {
+		return p.error(offset, "only singular queries can be compared")
+	}
+	if expression.Operator == FilterOperatorFunction {
+		if result := filterFunctionResult(expression); result != FilterFunctionTypeValue {
+			return p.error(offset, fmt.Sprintf("function %s returns %s and cannot be compared", expression.Function, result))
+		}
+	}
+	return nil
+}
+
+// checkTestable ensures that a function used as a test expression returns FilterFunctionTypeLogical or FilterFunctionTypeNodes.
+func (p *parser) checkTestable(expression *FilterExpression, offset int) error {
+	if expression.Operator != FilterOperatorFunction {
+		return p.error(offset, "expected function")
+	}
+	if result := filterFunctionResult(expression); result == FilterFunctionTypeValue {
+		return p.error(offset, fmt.Sprintf("function %s returns %s and must be compared", expression.Function, result))
+	}
+	return nil
+}
+
+// filterFunctionResult returns the result type of the registered function called in expression.
+func filterFunctionResult(expression *FilterExpression) FilterFunctionType {
+	if function, ok := GetFilterFunction(expression.Function); ok {
+		return function.Result
+	}
+	return 0
+}
+
+// parseParenExpression parses `"(" S logical-expr S ")"`.
+func (p *parser) parseParenExpression() (*FilterExpression, error) {
+	if _, err := p.expect(tokenLeftParen); err != nil {
+		return nil, err
+	}
+	expression, err := p.parseLogicalOr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRightParen); err != nil {
+		return nil, err
+	}
+	return expression, nil
+}
+
+// parseTestExpression parses a filter query used as an existence test.
+func (p *parser) parseTestExpression() (*FilterExpression, error) {
+	query, err := p.parseFilterQuery()
+	if err != nil {
+		return nil, err
+	}
+	return &FilterExpression{Operator: FilterOperatorExists, Operands: []*FilterExpression{query}}, nil
+}
+
+// parseComparable parses `comparable = literal / filter-query`.
+func (p *parser) parseComparable() (*FilterExpression, error) {
+	t, err := p.lexer.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.kind {
+	case tokenCurrent, tokenRoot:
+		return p.parseFilterQuery()
+	case tokenString:
+		_, _ = p.lexer.next()
+		return &FilterExpression{Operator: FilterOperatorLiteral, Literal: t.value}, nil
+	case tokenInteger, tokenNumber:
+		_, _ = p.lexer.next()
+		return p.parseNumberLiteral(t)
+	case tokenName:
+		_, _ = p.lexer.next()
+		if next, err := p.lexer.peek(); err != nil {
+			return nil, err
+		} else if next.kind == tokenLeftParen && !next.spaceBefore {
+			return p.parseFunctionExpression(t)
+		}
+		switch t.value {
+		case "true":
+			return &FilterExpression{Operator: FilterOperatorLiteral, Literal: true}, nil
+		case "false":
+			return &FilterExpression{Operator: FilterOperatorLiteral, Literal: false}, nil
+		case "null":
+			return &FilterExpression{Operator: FilterOperatorLiteral, Literal: nil}, nil
+		}
+		return nil, p.error(t.offset, fmt.Sprintf("unknown literal %s", t.value))
+	default:
+		return nil, p.unexpected(t, "filter query or literal")
+	}
+}
+
+/*
+parseFunctionExpression parses `function-expr = function-name "(" S [function-argument *(S "," S function-argument)] S ")"` after the function name has been consumed.
+
+Each argument is parsed according to the type of the corresponding parameter of the registered FilterFunction.
+*/
+func (p *parser) parseFunctionExpression(name token) (*FilterExpression, error) {
+	function, ok := GetFilterFunction(name.value)
+	if !ok {
+		return nil, p.error(name.offset, fmt.Sprintf("unknown function %s", name.value))
+	}
+
+	if _, err := p.expect(tokenLeftParen); err != nil {
+		return nil, err
+	}
+
+	expression := &FilterExpression{Operator: FilterOperatorFunction, Function: function.Name, Operands: make([]*FilterExpression, 0, len(function.Parameters))}
+	for i, parameter := range function.Parameters {
+		if i > 0 {
+			if _, err := p.expect(tokenComma); err != nil {
+				return nil, err
+			}
+		}
+
+		t, err := p.lexer.peek()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == tokenRightParen {
+			return nil, p.error(t.offset, fmt.Sprintf("function %s expects %d arguments", function.Name, len(function.Parameters)))
+		}
+
+		argument, err := p.parseFunctionArgument(parameter, t)
+		if err != nil {
+			return nil, err
+		}
+		expression.Operands = append(expression.Operands, argument)
+	}
+
+	t, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind == tokenComma {
+		return nil, p.error(t.offset, fmt.Sprintf("function %s expects %d arguments", function.Name, len(function.Parameters)))
+	}
+	if t.kind != tokenRightParen {
+		return nil, p.unexpected(t, tokenRightParen.String())
+	}
+
+	return expression, nil
+}
+
+// parseFunctionArgument parses a function argument that must be well-typed for parameter. t is the first token of the argument.
+func (p *parser) parseFunctionArgument(parameter FilterFunctionType, t token) (*FilterExpression, error) {
+	switch parameter {
+	case FilterFunctionTypeValue:
+		argument, err := p.parseComparable()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.checkComparable(argument, t.offset); err != nil {
+			return nil, err
+		}
+		return argument, nil
+	case FilterFunctionTypeNodes:
+		switch t.kind {
+		case tokenCurrent, tokenRoot:
+			return p.parseFilterQuery()
+		case tokenName:
+			argument, err := p.parseComparable()
+			if err != nil {
+				return nil, err
+			}
+			if argument.Operator != FilterOperatorFunction || filterFunctionResult(argument) != FilterFunctionTypeNodes {
+				return nil, p.error(t.offset, fmt.Sprintf("expected filter query or function returning %s", FilterFunctionTypeNodes))
+			}
+			return argument, nil
+		default:
+			return nil, p.unexpected(t, "filter query")
+		}
+	default:
+		return p.parseLogicalOr()
+	}
+}
+
+// parseNumberLiteral converts an integer or number token into an int64 or float64 literal.
+func (p *parser) parseNumberLiteral(t token) (*FilterExpression, error) {
+	if t.kind == tokenInteger {
+		if value, err := strconv.ParseInt(t.value, 10, 64); err == nil {
+			return &FilterExpression{Operator: FilterOperatorLiteral, Literal: value}, nil
+		}
+	}
+	value, err := strconv.ParseFloat(t.value, 64)
+	if err != nil {
+		return nil, p.error(t.offset, fmt.Sprintf("number %s out of range", t.value))
+	}
+	return &FilterExpression{Operator: FilterOperatorLiteral, Literal: value}, nil
+}
+
+// parseFilterQuery parses `filter-query = rel-query / jsonpath-query`.
+func (p *parser) parseFilterQuery() (*FilterExpression, error) {
+	t, err := p.lexer.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind != tokenCurrent && t.kind != tokenRoot {
+		return nil, p.unexpected(t, "'@' or '$'")
+	}
+
+	segments, err := p.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+	return &FilterExpression{Operator: FilterOperatorQuery, Query: &FilterQuery{IsRelative: t.kind == tokenCurrent, Segments: segments}}, nil
+}
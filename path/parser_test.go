@@ -0,0 +1,419 @@
+package path
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rogonion/go-json/core"
+)
+
+func TestPath_ParseStrict(t *testing.T) {
+	for testData := range ParseStrictTestData {
+		result, err := testData.Path.ParseStrict()
+		if err != nil {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected ok, got err=", err,
+			)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, testData.ExpectedPathSegment) {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected=", core.JsonStringifyMust(testData.ExpectedPathSegment), "\n",
+				"got=", core.JsonStringifyMust(result),
+			)
+		}
+	}
+}
+
+func TestPath_FilterSelectorString(t *testing.T) {
+	for _, testData := range []struct {
+		Path     JSONPath
+		Expected string
+	}{
+		{Path: "$.orders[?(@.status=='open' && @.total>100)]", Expected: "$.orders[?@.status == 'open' && @.total > 100]"},
+		{Path: "$[?@.a == 'x' || !(@.b < 2 && $.c)]", Expected: "$[?@.a == 'x' || !(@.b < 2 && $.c)]"},
+		{Path: "$[?(@.a || @.b) && !@.c]", Expected: "$[?(@.a || @.b) && !@.c]"},
+		{Path: `$[?@.a=="it's"]`, Expected: `$[?@.a == 'it\'s']`},
+		{Path: "$[?@[0] >= -1.5e2 && @.b != null && @.c == true]", Expected: "$[?@[0] >= -150.0 && @.b != null && @.c == true]"},
+		{Path: "$..[?@..a]", Expected: "$..[?@..a]"},
+		{Path: "$.users[?length(@.roles)>2]", Expected: "$.users[?length(@.roles) > 2]"},
+		{Path: "$.items[?match(@.sku,'AB-[0-9]+')]", Expected: "$.items[?match(@.sku, 'AB-[0-9]+')]"},
+		{Path: "$[?!search(@.a, 'x') || count(@..b) >= value($.n)]", Expected: "$[?!search(@.a, 'x') || count(@..b) >= value($.n)]"},
+		{Path: "$[?match(@.a, @.b)&&length(value(@.*))==1]", Expected: "$[?match(@.a, @.b) && length(value(@.*)) == 1]"},
+	} {
+		result, err := testData.Path.ParseStrict()
+		if err != nil {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected ok, got err=", err,
+			)
+			continue
+		}
+
+		if result.String() != testData.Expected {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected=", testData.Expected, "\n",
+				"got=", result.String(),
+			)
+		}
+
+		if reparsed := JSONPath(result.String()).Parse(); !reflect.DeepEqual(reparsed, result) {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected String() to parse back to the same segments",
+			)
+		}
+	}
+}
+
+func TestPath_ParseStrictInvalid(t *testing.T) {
+	for testData := range ParseStrictInvalidTestData {
+		_, err := testData.Path.ParseStrict()
+		if err == nil {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected err, got ok",
+			)
+			continue
+		}
+
+		if !errors.Is(err, ErrJSONPathSyntaxError) {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected err to be ErrJSONPathSyntaxError, got=", err,
+			)
+		}
+
+		var pathError *core.Error
+		if !errors.As(err, &pathError) || pathError.Data["Offset"] != testData.ExpectedOffset {
+			t.Error(
+				"path=", testData.Path, "\n",
+				"expected offset=", testData.ExpectedOffset, "\n",
+				"got err=", err,
+			)
+		}
+	}
+}
+
+type ParseStrictInvalidData struct {
+	Path           JSONPath
+	ExpectedOffset int
+}
+
+// ParseStrictInvalidTestData contains queries that RFC 9535 rejects along with the offset of the offending character.
+func ParseStrictInvalidTestData(yield func(data *ParseStrictInvalidData) bool) {
+	for _, data := range []*ParseStrictInvalidData{
+		{Path: "", ExpectedOffset: 0},
+		{Path: " $", ExpectedOffset: 1},
+		{Path: "$ ", ExpectedOffset: 2},
+		{Path: "@.a", ExpectedOffset: 0},
+		{Path: "a.b", ExpectedOffset: 0},
+		{Path: "$a", ExpectedOffset: 1},
+		{Path: "$.", ExpectedOffset: 2},
+		{Path: "$. a", ExpectedOffset: 3},
+		{Path: "$..", ExpectedOffset: 3},
+		{Path: "$...a", ExpectedOffset: 3},
+		{Path: "$.1a", ExpectedOffset: 2},
+		{Path: "$.['a']", ExpectedOffset: 2},
+		{Path: "$.[0]", ExpectedOffset: 2},
+		{Path: "$[", ExpectedOffset: 2},
+		{Path: "$[]", ExpectedOffset: 2},
+		{Path: "$['a'", ExpectedOffset: 5},
+		{Path: "$['a',]", ExpectedOffset: 6},
+		{Path: "$[a]", ExpectedOffset: 2},
+		{Path: "$['a]", ExpectedOffset: 2},
+		{Path: "$['a\\x']", ExpectedOffset: 4},
+		{Path: "$['\\uD800']", ExpectedOffset: 3},
+		{Path: "$['\\uDC00']", ExpectedOffset: 3},
+		{Path: "$['a\tb']", ExpectedOffset: 4},
+		{Path: "$[01]", ExpectedOffset: 2},
+		{Path: "$[-0]", ExpectedOffset: 2},
+		{Path: "$[1.0]", ExpectedOffset: 2},
+		{Path: "$[9007199254740992]", ExpectedOffset: 2},
+		{Path: "$[1:2:3:4]", ExpectedOffset: 7},
+		{Path: "$[1 2]", ExpectedOffset: 4},
+		{Path: "$.a]", ExpectedOffset: 3},
+		{Path: "$.a[0]b", ExpectedOffset: 6},
+		{Path: "$.a\x00", ExpectedOffset: 3},
+		{Path: "$[?1]", ExpectedOffset: 4},
+		{Path: "$[?!1]", ExpectedOffset: 4},
+		{Path: "$[?@.a=1]", ExpectedOffset: 6},
+		{Path: "$[?@.a==]", ExpectedOffset: 8},
+		{Path: "$[?@.* == 1]", ExpectedOffset: 3},
+		{Path: "$[?(@.a]", ExpectedOffset: 7},
+		{Path: "$[?@.a && ]", ExpectedOffset: 10},
+		{Path: "$[?foo(@.a)]", ExpectedOffset: 3},
+		{Path: "$[?length(@.*) > 1]", ExpectedOffset: 10},
+		{Path: "$[?length(@.a)]", ExpectedOffset: 3},
+		{Path: "$[?!length(@.a)]", ExpectedOffset: 4},
+		{Path: "$[?match(@.a, 'x') == true]", ExpectedOffset: 3},
+		{Path: "$[?length()]", ExpectedOffset: 10},
+		{Path: "$[?length(@.a, 1)]", ExpectedOffset: 13},
+		{Path: "$[?count(1) > 0]", ExpectedOffset: 9},
+		{Path: "$[?length (@.a) > 0]", ExpectedOffset: 3},
+		{Path: "$[0,-]", ExpectedOffset: 4},
+		{Path: "$.-", ExpectedOffset: 2},
+		{Path: "$[?@.a == -]", ExpectedOffset: 10},
+	} {
+		if !yield(data) {
+			return
+		}
+	}
+}
+
+// ParseStrictTestData contains queries from the RFC 9535 grammar with their expected segments.
+func ParseStrictTestData(yield func(data *ParseData) bool) {
+	root := func() *CollectionMemberSegment {
+		return &CollectionMemberSegment{Key: "$", IsKeyRoot: true, ExpectLinear: true, ExpectAssociative: true}
+	}
+
+	for testData := range ParseDataTestData {
+		if !yield(testData) {
+			return
+		}
+	}
+
+	for _, data := range []*ParseData{
+		{
+			Path:                "$",
+			ExpectedPathSegment: RecursiveDescentSegments{{root()}},
+		},
+		{
+			Path: "$.store.book",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "store", IsKey: true, ExpectAssociative: true},
+					{Key: "book", IsKey: true, ExpectAssociative: true},
+				},
+			},
+		},
+		{
+			Path: "$.items[-]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "items", IsKey: true, ExpectAssociative: true},
+					{Key: "-", IsIndexEnd: true, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: `$["a'b"]['c\'d']['é𝄞']`,
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "a'b", IsKey: true, ExpectAssociative: true},
+					{Key: "c'd", IsKey: true, ExpectAssociative: true},
+					{Key: "é𝄞", IsKey: true, ExpectAssociative: true},
+				},
+			},
+		},
+		{
+			Path: "$.café._x1",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "café", IsKey: true, ExpectAssociative: true},
+					{Key: "_x1", IsKey: true, ExpectAssociative: true},
+				},
+			},
+		},
+		{
+			Path: "$ [ 'a' , 1 ] .b",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{
+						UnionSelector: RecursiveDescentSegment{
+							{Key: "a", IsKey: true},
+							{Index: 1, IsIndex: true},
+						},
+						ExpectLinear:      true,
+						ExpectAssociative: true,
+					},
+					{Key: "b", IsKey: true, ExpectAssociative: true},
+				},
+			},
+		},
+		{
+			Path: "$.a[*].*",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "a", IsKey: true, ExpectAssociative: true},
+					{Key: "*", IsKeyIndexAll: true, ExpectAssociative: true, ExpectLinear: true},
+					{Key: "*", IsKeyIndexAll: true, ExpectAssociative: true},
+				},
+			},
+		},
+		{
+			Path: "$[1:]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{LinearCollectionSelector: &LinearCollectionSelector{Start: 1, IsStart: true}, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$[:3]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{LinearCollectionSelector: &LinearCollectionSelector{End: 3, IsEnd: true}, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$[ 1 : 5 : 2 ]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{LinearCollectionSelector: &LinearCollectionSelector{Start: 1, IsStart: true, End: 5, IsEnd: true, Step: 2, IsStep: true}, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$[-1]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Index: -1, IsIndex: true, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$[-3:]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{LinearCollectionSelector: &LinearCollectionSelector{Start: -3, IsStart: true}, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$[::-1]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{LinearCollectionSelector: &LinearCollectionSelector{Step: -1, IsStep: true}, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$[0,-1]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{
+						UnionSelector: RecursiveDescentSegment{
+							{Index: 0, IsIndex: true},
+							{Index: -1, IsIndex: true},
+						},
+						ExpectLinear:      true,
+						ExpectAssociative: true,
+					},
+				},
+			},
+		},
+		{
+			Path: "$[::]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "*", IsKeyIndexAll: true, ExpectAssociative: true, ExpectLinear: true},
+				},
+			},
+		},
+		{
+			Path: "$..*..[0]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{root()},
+				{{Key: "*", IsKeyIndexAll: true, ExpectAssociative: true}},
+				{{Index: 0, IsIndex: true, ExpectLinear: true}},
+			},
+		},
+		{
+			Path: "$.orders[?@.status == 'open' && @.total > 100]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{Key: "orders", IsKey: true, ExpectAssociative: true},
+					{
+						FilterSelector: &FilterExpression{
+							Operator: FilterOperatorAnd,
+							Operands: []*FilterExpression{
+								{
+									Operator: FilterOperatorEqual,
+									Operands: []*FilterExpression{
+										{Operator: FilterOperatorQuery, Query: &FilterQuery{IsRelative: true, Segments: RecursiveDescentSegments{{root(), {Key: "status", IsKey: true, ExpectAssociative: true}}}}},
+										{Operator: FilterOperatorLiteral, Literal: "open"},
+									},
+								},
+								{
+									Operator: FilterOperatorGreater,
+									Operands: []*FilterExpression{
+										{Operator: FilterOperatorQuery, Query: &FilterQuery{IsRelative: true, Segments: RecursiveDescentSegments{{root(), {Key: "total", IsKey: true, ExpectAssociative: true}}}}},
+										{Operator: FilterOperatorLiteral, Literal: int64(100)},
+									},
+								},
+							},
+						},
+						ExpectLinear:      true,
+						ExpectAssociative: true,
+					},
+				},
+			},
+		},
+		{
+			Path: "$[?!@.a]",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{
+					root(),
+					{
+						FilterSelector: &FilterExpression{
+							Operator: FilterOperatorNot,
+							Operands: []*FilterExpression{
+								{
+									Operator: FilterOperatorExists,
+									Operands: []*FilterExpression{
+										{Operator: FilterOperatorQuery, Query: &FilterQuery{IsRelative: true, Segments: RecursiveDescentSegments{{root(), {Key: "a", IsKey: true, ExpectAssociative: true}}}}},
+									},
+								},
+							},
+						},
+						ExpectLinear:      true,
+						ExpectAssociative: true,
+					},
+				},
+			},
+		},
+		{
+			Path: "$..['a','b'].c",
+			ExpectedPathSegment: RecursiveDescentSegments{
+				{root()},
+				{
+					{
+						UnionSelector: RecursiveDescentSegment{
+							{Key: "a", IsKey: true},
+							{Key: "b", IsKey: true},
+						},
+						ExpectLinear:      true,
+						ExpectAssociative: true,
+					},
+					{Key: "c", IsKey: true, ExpectAssociative: true},
+				},
+			},
+		},
+	} {
+		if !yield(data) {
+			return
+		}
+	}
+}
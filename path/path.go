@@ -8,11 +8,17 @@ import (
 /*
 Parse breaks down a JSONPath string into a structured 2D slice of segments.
 
-The parsing process involves:
- 1. Splitting the path by the recursive descent operator (`..`).
+The path is first parsed with ParseStrict. If the path does not conform to the RFC 9535 grammar,
+Parse falls back to the lenient pattern-based parser which:
+ 1. Splits the path by the recursive descent operator (`..`).
  2. For each resulting section:
-    a. Splitting by the dot notation pattern (`.`).
-    b. Extracting individual collection members (brackets, indices, keys).
+    a. Splits by the dot notation pattern (`.`).
+    b. Extracts individual collection members (brackets, indices, keys).
+
+The lenient parser silently drops parts of the path it does not understand. Use ParseStrict when the path comes from user input.
+
+A path that starts with `/` is parsed as a JSONPointer e.g., `/store/book/0` hence it can be passed to the Object methods directly.
+An invalid JSONPointer results in empty RecursiveDescentSegments. Use JSONPointer.Parse to get the error. The Object methods return it.
 
 It returns a RecursiveDescentSegments object, which is a 2D slice. The top-level slice
 represents parts of the path separated by recursive descent, and the inner slice contains
@@ -24,6 +30,21 @@ Example:
 	segments := path.Parse()
 */
 func (jsonPath JSONPath) Parse() RecursiveDescentSegments {
+	if strings.HasPrefix(string(jsonPath), JsonPointerSeparator) {
+		if segment, err := JSONPointer(jsonPath).Parse(); err == nil {
+			return RecursiveDescentSegments{segment}
+		}
+		return RecursiveDescentSegments{}
+	}
+
+	if segments, err := jsonPath.ParseStrict(); err == nil {
+		return segments
+	}
+	return jsonPath.parseLenient()
+}
+
+// parseLenient parses jsonPath using the pattern-based splitting and extraction functions.
+func (jsonPath JSONPath) parseLenient() RecursiveDescentSegments {
 	recursiveDescentSegments := jsonPath.SplitPathByRecursiveDescentPattern()
 
 	segments := make(RecursiveDescentSegments, 0)
@@ -90,6 +111,144 @@ func (n RecursiveDescentSegment) String() string {
 	return ""
 }
 
+/*
+NormalizedString returns the RFC 9535 normalized path of a segment whose members are the root, keys, and indexes e.g., `$['store']['book'][2]['title']`.
+
+Every key is written in bracket notation with single quotes and escaped as described in RFC 9535 section 2.7.
+The root `$` is always written first.
+Members that are not keys or indexes (e.g., wildcards or filters) are written in their String form.
+
+Paths returned by Object.GetAll and passed to Object.ForEach callbacks can be converted with this method and parsed back with JSONPath.Parse.
+*/
+func (n RecursiveDescentSegment) NormalizedString() string {
+	var builder strings.Builder
+	builder.WriteString(JsonpathKeyRoot)
+	for _, s := range n {
+		switch {
+		case s == nil || s.IsKeyRoot:
+			continue
+		case s.IsIndexEnd:
+			builder.WriteString(JsonpathLeftBracket + JsonpathIndexEnd + JsonpathRightBracket)
+		case s.IsIndex:
+			builder.WriteString(fmt.Sprintf("%s%d%s", JsonpathLeftBracket, s.Index, JsonpathRightBracket))
+		case s.IsKey:
+			builder.WriteString(JsonpathLeftBracket)
+			builder.WriteString(getQuotedJsonKey(s.Key))
+			builder.WriteString(JsonpathRightBracket)
+		default:
+			builder.WriteString(s.String())
+		}
+	}
+	return builder.String()
+}
+
+// writeNormalizedKey writes key escaped for use in a single-quoted normalized path member name.
+func writeNormalizedKey(builder *strings.Builder, key string) {
+	for _, r := range key {
+		switch r {
+		case '\'':
+			builder.WriteString(`\'`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\b':
+			builder.WriteString(`\b`)
+		case '\f':
+			builder.WriteString(`\f`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				builder.WriteString(fmt.Sprintf(`\u%04x`, r))
+				continue
+			}
+			builder.WriteRune(r)
+		}
+	}
+}
+
+/*
+Indices returns the indexes selected in a linear collection of the given length, in the order they are selected.
+
+It follows the slice semantics of RFC 9535 section 2.3.4.2:
+  - Step defaults to 1. A Step of 0 selects nothing.
+  - Start and End default to the first and last element (inclusive) respectively in the direction of Step.
+  - Negative Start and End count from the end of the collection e.g., `[-3:]` selects the last three elements.
+  - Start and End are clamped to the bounds of the collection.
+
+Example:
+
+	(&LinearCollectionSelector{Step: -1, IsStep: true}).Indices(3) // [2 1 0]
+*/
+func (n *LinearCollectionSelector) Indices(length int) []int {
+	indices := make([]int, 0)
+	if n == nil || length <= 0 {
+		return indices
+	}
+
+	step := 1
+	if n.IsStep {
+		step = n.Step
+	}
+	if step == 0 {
+		return indices
+	}
+
+	normalize := func(index int) int {
+		if index < 0 {
+			return length + index
+		}
+		return index
+	}
+
+	var lower, upper int
+	if step > 0 {
+		start, end := 0, length
+		if n.IsStart {
+			start = normalize(n.Start)
+		}
+		if n.IsEnd {
+			end = normalize(n.End)
+		}
+		lower = min(max(start, 0), length)
+		upper = min(max(end, 0), length)
+		for i := lower; i < upper; i += step {
+			indices = append(indices, i)
+		}
+		return indices
+	}
+
+	start, end := length-1, -length-1
+	if n.IsStart {
+		start = normalize(n.Start)
+	}
+	if n.IsEnd {
+		end = normalize(n.End)
+	}
+	upper = min(max(start, -1), length-1)
+	lower = min(max(end, -1), length-1)
+	for i := upper; lower < i; i += step {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+/*
+NormalizeIndex converts an index selector into an index in a linear collection of the given length.
+
+Negative indexes count from the end of the collection e.g., -1 is the last element.
+Returns false if the index is out of range.
+*/
+func NormalizeIndex(index int, length int) (int, bool) {
+	if index < 0 {
+		index += length
+	}
+	return index, index >= 0 && index < length
+}
+
 // String returns the string representation of a linear collection selector (e.g., "[1:5:2]").
 func (n *LinearCollectionSelector) String() string {
 	if n == nil {
@@ -118,7 +277,7 @@ func (n *CollectionMemberSegment) String() string {
 		return ""
 	}
 
-	if n.IsKey && n.Key != "" {
+	if n.IsKey {
 		return getJsonKey(n.Key)
 	}
 
@@ -137,13 +296,26 @@ func (n *CollectionMemberSegment) String() string {
 		return fmt.Sprintf("%s%d%s", JsonpathLeftBracket, n.Index, JsonpathRightBracket)
 	}
 
+	if n.IsIndexEnd {
+		return JsonpathLeftBracket + JsonpathIndexEnd + JsonpathRightBracket
+	}
+
 	if n.LinearCollectionSelector != nil {
 		return n.LinearCollectionSelector.String()
 	}
 
+	if n.FilterSelector != nil {
+		return fmt.Sprintf("%s%s%s%s", JsonpathLeftBracket, JsonpathFilter, n.FilterSelector, JsonpathRightBracket)
+	}
+
 	if len(n.UnionSelector) > 0 {
 		segmentsStr := make([]string, 0)
 		for _, u := range n.UnionSelector {
+			if u != nil && u.IsKey {
+				segmentsStr = append(segmentsStr, getQuotedJsonKey(u.Key))
+				continue
+			}
+
 			uStr := u.String()
 			if uStr != "" {
 				if strings.HasPrefix(uStr, JsonpathLeftBracket) {
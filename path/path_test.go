@@ -18,6 +18,99 @@ func TestPath_ParseReverse(t *testing.T) {
 	}
 }
 
+func TestPath_LinearCollectionSelectorIndices(t *testing.T) {
+	for _, testData := range []struct {
+		Path     JSONPath
+		Length   int
+		Expected []int
+	}{
+		{Path: "$[1:3]", Length: 5, Expected: []int{1, 2}},
+		{Path: "$[5:]", Length: 5, Expected: []int{}},
+		{Path: "$[1:5:2]", Length: 7, Expected: []int{1, 3}},
+		{Path: "$[-3:]", Length: 5, Expected: []int{2, 3, 4}},
+		{Path: "$[-10:2]", Length: 5, Expected: []int{0, 1}},
+		{Path: "$[:-1]", Length: 4, Expected: []int{0, 1, 2}},
+		{Path: "$[::-1]", Length: 4, Expected: []int{3, 2, 1, 0}},
+		{Path: "$[5:1:-2]", Length: 7, Expected: []int{5, 3}},
+		{Path: "$[-1:-3:-1]", Length: 4, Expected: []int{3, 2}},
+		{Path: "$[10::-3]", Length: 7, Expected: []int{6, 3, 0}},
+		{Path: "$[1:0]", Length: 3, Expected: []int{}},
+		{Path: "$[::0]", Length: 3, Expected: []int{}},
+		{Path: "$[::2]", Length: 0, Expected: []int{}},
+	} {
+		segments, err := testData.Path.ParseStrict()
+		if err != nil {
+			t.Error("path=", testData.Path, "expected ok, got err=", err)
+			continue
+		}
+
+		result := segments[0][1].LinearCollectionSelector.Indices(testData.Length)
+		if !reflect.DeepEqual(result, testData.Expected) {
+			t.Error(
+				"path=", testData.Path, "length=", testData.Length, "\n",
+				"expected=", testData.Expected, "\n",
+				"got=", result,
+			)
+		}
+	}
+}
+
+func TestPath_NormalizeIndex(t *testing.T) {
+	for _, testData := range []struct {
+		Index         int
+		Length        int
+		ExpectedIndex int
+		ExpectedOk    bool
+	}{
+		{Index: 0, Length: 3, ExpectedIndex: 0, ExpectedOk: true},
+		{Index: 3, Length: 3, ExpectedIndex: 3, ExpectedOk: false},
+		{Index: -1, Length: 3, ExpectedIndex: 2, ExpectedOk: true},
+		{Index: -3, Length: 3, ExpectedIndex: 0, ExpectedOk: true},
+		{Index: -4, Length: 3, ExpectedIndex: -1, ExpectedOk: false},
+	} {
+		index, ok := NormalizeIndex(testData.Index, testData.Length)
+		if index != testData.ExpectedIndex || ok != testData.ExpectedOk {
+			t.Error(
+				"index=", testData.Index, "length=", testData.Length, "\n",
+				"expected=", testData.ExpectedIndex, testData.ExpectedOk, "\n",
+				"got=", index, ok,
+			)
+		}
+	}
+}
+
+func TestPath_NormalizedString(t *testing.T) {
+	for _, testData := range []struct {
+		Segment  RecursiveDescentSegment
+		Expected string
+	}{
+		{Segment: RecursiveDescentSegment{}, Expected: "$"},
+		{
+			Segment:  RecursiveDescentSegment{{Key: "$", IsKeyRoot: true}, {Key: "store", IsKey: true}, {Key: "book", IsKey: true}, {Index: 2, IsIndex: true}, {Key: "title", IsKey: true}},
+			Expected: "$['store']['book'][2]['title']",
+		},
+		{
+			Segment:  RecursiveDescentSegment{{Key: "it's", IsKey: true}, {Key: "a\\b\n\u0001", IsKey: true}},
+			Expected: `$['it\'s']['a\\b\n\u0001']`,
+		},
+	} {
+		normalizedString := testData.Segment.NormalizedString()
+		if normalizedString != testData.Expected {
+			t.Error("expected=", testData.Expected, "\n", "got=", normalizedString)
+			continue
+		}
+
+		segments, err := JSONPath(normalizedString).ParseStrict()
+		if err != nil {
+			t.Error("expected normalized path to parse, path=", normalizedString, "err=", err)
+			continue
+		}
+		if reparsed := segments[0].NormalizedString(); reparsed != normalizedString {
+			t.Error("expected roundtrip=", normalizedString, "\n", "got=", reparsed)
+		}
+	}
+}
+
 func TestPath_Parse(t *testing.T) {
 	for testData := range ParseDataTestData {
 		result := testData.Path.Parse()
@@ -7,7 +7,7 @@ using the recursive descent operator ('..') as the delimiter.
 It respects brackets and quotes, ensuring that '..' inside string literals is not treated as a delimiter.
 */
 func (jsonPath JSONPath) SplitPathByRecursiveDescentPattern() []JSONPath {
-	matches := recursiveDescentPatternRegex().FindAllStringSubmatchIndex(string(jsonPath), -1)
+	matches := recursiveDescentPatternRegex.FindAllStringSubmatchIndex(string(jsonPath), -1)
 
 	recursiveDescentPaths := make([]JSONPath, 0)
 	recursiveDescentIndexes := make([][2]int, 0)
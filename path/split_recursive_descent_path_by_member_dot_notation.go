@@ -9,7 +9,7 @@ ensuring that dots inside string literals or bracket notation are not treated as
 func (jsonPath JSONPath) SplitPathSegmentByDotNotationPattern() []JSONPath {
 	dotNotationPaths := make([]JSONPath, 0)
 
-	matches := memberDotNotationPatternRegex().FindAllStringSubmatchIndex(string(jsonPath), -1)
+	matches := memberDotNotationPatternRegex.FindAllStringSubmatchIndex(string(jsonPath), -1)
 
 	memberDotNotationIndexes := make([][2]int, 0)
 	for _, match := range matches {
@@ -189,12 +189,19 @@ func (n *Conversion) convertToStructWithDynamicSchemaNode(source reflect.Value,
 			newStruct = reflect.New(schema.Type).Elem()
 		}
 
+		// Embedded structs without a schema whose promoted fields are converted individually.
+		embeddedStructs := make([]int, 0)
+
 		for i := 0; i < schema.Type.NumField(); i++ {
 			destField := schema.Type.Field(i)
-			sourceField := source.FieldByName(destField.Name)
+			sourceField := structFieldByName(source, destField.Name)
 
 			childSchema, ok := schema.ChildNodes[destField.Name]
 			if !ok {
+				if destField.Anonymous {
+					embeddedStructs = append(embeddedStructs, i)
+					continue
+				}
 				return reflect.Zero(schema.Type), NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("schema for field %s has not been found for struct conversion", destField.Name)).
 					WithNestedError(ErrDataConversionFailed).
 					WithData(core.JsonObject{"Schema": schema, "Source": source.Interface(), "PathSegments": pathSegments})
@@ -216,6 +223,40 @@ func (n *Conversion) convertToStructWithDynamicSchemaNode(source reflect.Value,
 			}
 		}
 
+		if len(embeddedStructs) > 0 {
+			for _, structField := range n.fieldNameStrategy.Fields(schema.Type) {
+				if len(structField.Index) == 1 || !slices.Contains(embeddedStructs, structField.Index[0]) {
+					continue
+				}
+
+				childSchema, ok := getStructFieldSchema(schema, structField)
+				if !ok {
+					return reflect.Zero(schema.Type), NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("schema for promoted field %s has not been found for struct conversion", structField.Field.Name)).
+						WithNestedError(ErrDataConversionFailed).
+						WithData(core.JsonObject{"Schema": schema, "Source": source.Interface(), "PathSegments": pathSegments})
+				}
+
+				sourceField := structFieldByName(source, structField.Field.Name)
+				if !sourceField.IsValid() {
+					continue
+				}
+
+				currentPathSegments := append(pathSegments, &path.CollectionMemberSegment{Key: structField.Name, IsKey: true})
+
+				destFieldValue := structField.SettableValue(newStruct)
+				if !destFieldValue.IsValid() || !destFieldValue.CanSet() {
+					continue
+				}
+				if convertedValue, err := n.RecursiveConvert(sourceField, childSchema, currentPathSegments); err == nil {
+					destFieldValue.Set(convertedValue)
+				} else if !schema.Nilable {
+					return reflect.Zero(schema.Type), NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("RecursiveConvert value for promoted struct field %s failed", structField.Field.Name)).
+						WithNestedError(err).
+						WithData(core.JsonObject{"Schema": schema, "Source": source.Interface(), "PathSegments": pathSegments})
+				}
+			}
+		}
+
 		return newStruct, nil
 	case reflect.Map:
 		var newStruct reflect.Value
@@ -234,15 +275,25 @@ func (n *Conversion) convertToStructWithDynamicSchemaNode(source reflect.Value,
 					WithData(core.JsonObject{"Schema": schema, "Source": source.Interface(), "PathSegments": pathSegments})
 			}
 
+			if n.fieldNameStrategy.IsAmbiguous(schema.Type, key.String()) {
+				return reflect.Zero(schema.Type), NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("map key %s is ambiguous in struct conversion", key)).
+					WithNestedError(ErrDataConversionFailed).
+					WithData(core.JsonObject{"Schema": schema, "Source": source.Interface(), "PathSegments": pathSegments})
+			}
+
 			// Find the corresponding field in the destination struct.
-			field := newStruct.FieldByName(key.String())
+			structField, ok := n.fieldNameStrategy.Field(schema.Type, key.String())
+			var field reflect.Value
+			if ok {
+				field = structField.SettableValue(newStruct)
+			}
 			if !field.IsValid() || !field.CanSet() {
 				return reflect.Zero(schema.Type), NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("map key %s is not a valid field in struct conversion", key)).
 					WithNestedError(ErrDataConversionFailed).
 					WithData(core.JsonObject{"Schema": schema, "Source": source.Interface(), "PathSegments": pathSegments})
 			}
 
-			childSchema, ok := schema.ChildNodes[key.String()]
+			childSchema, ok := getStructFieldSchema(schema, structField)
 			if !ok {
 				return reflect.Zero(schema.Type), NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("schema for field %s has not been found for struct conversion", key)).
 					WithNestedError(ErrDataConversionFailed).
@@ -418,9 +469,13 @@ func (n *Conversion) convertToMapWithDynamicSchemaNode(source reflect.Value, sch
 			newMap = reflect.MakeMap(schema.Type)
 		}
 
-		for i := 0; i < source.NumField(); i++ {
-			field := source.Field(i)
-			fieldName := source.Type().Field(i).Name
+		for _, structField := range n.fieldNameStrategy.Fields(source.Type()) {
+			field := structField.Value(source)
+			if !field.IsValid() {
+				// promoted through a nil pointer to an embedded struct.
+				continue
+			}
+			fieldName := structField.Name
 			currentPathSegments := append(pathSegments, &path.CollectionMemberSegment{Key: fieldName, IsKey: true})
 
 			if childSchema, ok := schema.ChildNodes[fieldName]; ok {
@@ -853,6 +908,20 @@ func (n *Conversion) SetCustomConverters(value Converters) {
 	n.customConverters = value
 }
 
+/*
+WithFieldNameStrategy sets the core.FieldNameStrategy used to match map keys to struct fields e.g., `first_name` to FirstName with core.FieldNameJSONTag, and to name map keys when converting a struct to a map.
+
+ChildNodes of a struct schema are always looked up by the Go name of a field.
+*/
+func (n *Conversion) WithFieldNameStrategy(value *core.FieldNameStrategy) *Conversion {
+	n.fieldNameStrategy = value
+	return n
+}
+
+func (n *Conversion) SetFieldNameStrategy(value *core.FieldNameStrategy) {
+	n.fieldNameStrategy = value
+}
+
 func NewConversion() *Conversion {
 	n := new(Conversion)
 	return n
@@ -891,5 +960,23 @@ Example:
 	err := converter.Convert(source, schema, &destination)
 */
 type Conversion struct {
-	customConverters Converters
+	customConverters  Converters
+	fieldNameStrategy *core.FieldNameStrategy
+}
+
+/*
+structFieldByName returns the field of structValue with the Go name name including promoted fields.
+
+Unlike reflect.Value.FieldByName, it returns an invalid reflect.Value instead of panicking if the field is promoted through a nil pointer to an embedded struct.
+*/
+func structFieldByName(structValue reflect.Value, name string) reflect.Value {
+	structField, ok := structValue.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}
+	}
+	fieldValue, err := structValue.FieldByIndexErr(structField.Index)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return fieldValue
 }
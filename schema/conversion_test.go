@@ -438,3 +438,40 @@ func TestSchema_ConvertStoreResultInTypedDestination(t *testing.T) {
 		t.Fatal("Convert Address map to struct failed", err)
 	}
 }
+
+func TestSchema_ConvertWithFieldNameStrategy(t *testing.T) {
+	cvt := NewConversion().WithFieldNameStrategy(core.FieldNameJSONTag)
+
+	var item TaggedItem
+	if err := cvt.Convert(map[string]any{"item_id": "7", "item_name": "Widget"}, TaggedItemSchema(), &item); err != nil || item != (TaggedItem{ItemID: 7, ItemName: "Widget"}) {
+		t.Error("expected map with json tag keys to be converted to struct, got=", item, "err=", err)
+	}
+
+	if err := cvt.Convert(map[string]any{"Secret": "x"}, TaggedItemSchema(), &item); err == nil {
+		t.Error("expected error for field tagged `json:\"-\"`")
+	}
+
+	var itemMap map[string]any
+	if err := cvt.Convert(TaggedItem{ItemID: 7, ItemName: "Widget", Secret: "x"}, JsonMapSchema(), &itemMap); err != nil || !reflect.DeepEqual(itemMap, map[string]any{"item_id": 7, "item_name": "Widget"}) {
+		t.Error("expected struct to be converted to map with json tag keys, got=", itemMap, "err=", err)
+	}
+}
+
+func TestSchema_ConvertEmbedded(t *testing.T) {
+	cvt := NewConversion()
+
+	var order EmbeddedOrder
+	if err := cvt.Convert(map[string]any{"ID": "7", "CreatedBy": "admin", "Total": 9.5}, EmbeddedOrderSchema(), &order); err != nil ||
+		!reflect.DeepEqual(order, EmbeddedOrder{EmbeddedBase: EmbeddedBase{ID: 7}, EmbeddedAudit: &EmbeddedAudit{CreatedBy: "admin"}, Total: 9.5}) {
+		t.Error("expected map with promoted keys to be converted, got=", order, "err=", err)
+	}
+
+	if err := cvt.Convert(map[string]any{"Version": 1}, EmbeddedOrderSchema(), &order); err == nil {
+		t.Error("expected error for ambiguous map key Version")
+	}
+
+	var orderMap map[string]any
+	if err := cvt.Convert(EmbeddedOrder{EmbeddedBase: EmbeddedBase{ID: 7}, Total: 9.5}, JsonMapSchema(), &orderMap); err != nil || !reflect.DeepEqual(orderMap, map[string]any{"ID": 7, "Total": 9.5}) {
+		t.Error("expected promoted fields in map, got=", orderMap, "err=", err)
+	}
+}
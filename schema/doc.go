@@ -26,6 +26,10 @@ To convert data (e.g. a map) into a struct based on a schema:
 
 You can register custom converters for specific types (like UUIDs) using `WithCustomConverters`.
 
+Map keys are matched to struct fields by their Go name. Use `WithFieldNameStrategy` with e.g., core.FieldNameJSONTag to match them by the names in `json` tags instead.
+
+Fields promoted from embedded structs are matched like in Go. Their schemas are looked up in the ChildNodes entry of the embedded struct then in the ChildNodes of the outer struct.
+
 Example:
 
 	schema := &DynamicSchemaNode{
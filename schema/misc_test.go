@@ -497,3 +497,95 @@ func (n *Pgxuuid) ValidateData(data any, currentSchema Schema, pathSegments path
 		return false, NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("unsupported type %T", data)).WithData(core.JsonObject{"Schema": currentSchema, "PathSegments": pathSegments, "Data": data})
 	}
 }
+
+// TaggedItem is a struct with `json` tags for testing core.FieldNameStrategy.
+type TaggedItem struct {
+	ItemID   int    `json:"item_id"`
+	ItemName string `json:"item_name"`
+	Secret   string `json:"-"`
+}
+
+func TaggedItemSchema() *DynamicSchemaNode {
+	return &DynamicSchemaNode{
+		Kind: reflect.Struct,
+		Type: reflect.TypeOf(TaggedItem{}),
+		ChildNodes: ChildNodes{
+			"ItemID": &DynamicSchemaNode{
+				Kind: reflect.Int,
+				Type: reflect.TypeOf(0),
+			},
+			"ItemName": &DynamicSchemaNode{
+				Kind: reflect.String,
+				Type: reflect.TypeOf(""),
+			},
+			"Secret": &DynamicSchemaNode{
+				Kind: reflect.String,
+				Type: reflect.TypeOf(""),
+			},
+		},
+	}
+}
+
+// EmbeddedBase is embedded in EmbeddedOrder for testing promoted fields.
+type EmbeddedBase struct {
+	ID      int
+	Version int
+}
+
+// EmbeddedAudit is embedded in EmbeddedOrder as a pointer for testing promoted fields.
+type EmbeddedAudit struct {
+	CreatedBy string
+	Version   int
+}
+
+type EmbeddedOrder struct {
+	EmbeddedBase
+	*EmbeddedAudit
+	Total float64
+}
+
+func EmbeddedOrderSchema() *DynamicSchemaNode {
+	return &DynamicSchemaNode{
+		Kind: reflect.Struct,
+		Type: reflect.TypeOf(EmbeddedOrder{}),
+		ChildNodes: ChildNodes{
+			"EmbeddedBase": &DynamicSchemaNode{
+				Kind: reflect.Struct,
+				Type: reflect.TypeOf(EmbeddedBase{}),
+				ChildNodes: ChildNodes{
+					"ID": &DynamicSchemaNode{
+						Kind: reflect.Int,
+						Type: reflect.TypeOf(0),
+					},
+					"Version": &DynamicSchemaNode{
+						Kind: reflect.Int,
+						Type: reflect.TypeOf(0),
+					},
+				},
+			},
+			"EmbeddedAudit": &DynamicSchemaNode{
+				Kind:    reflect.Pointer,
+				Type:    reflect.TypeOf(&EmbeddedAudit{}),
+				Nilable: true,
+				ChildNodesPointerSchema: &DynamicSchemaNode{
+					Kind: reflect.Struct,
+					Type: reflect.TypeOf(EmbeddedAudit{}),
+					ChildNodes: ChildNodes{
+						"CreatedBy": &DynamicSchemaNode{
+							Kind: reflect.String,
+							Type: reflect.TypeOf(""),
+						},
+						"Version": &DynamicSchemaNode{
+							Kind: reflect.Int,
+							Type: reflect.TypeOf(0),
+						},
+					},
+				},
+			},
+			"Total": &DynamicSchemaNode{
+				Kind: reflect.Float64,
+				Type: reflect.TypeOf(0.0),
+			},
+		},
+	}
+}
@@ -13,8 +13,20 @@ import (
 GetSchemaAtPath traverses the provided schema using the given path and returns the schema node corresponding to that path.
 
 It expects an absolute path (starting with $) and does not support recursive descent ('..') in the path query for schema retrieval.
+
+Struct fields in the path are resolved by their Go name. Use GetSchemaAtPathWithFieldNameStrategy for paths that use other names e.g., the names in `json` tags.
 */
 func GetSchemaAtPath[T SchemaPath](path T, schema Schema) (*DynamicSchemaNode, error) {
+	return GetSchemaAtPathWithFieldNameStrategy(path, schema, nil)
+}
+
+/*
+GetSchemaAtPathWithFieldNameStrategy is like GetSchemaAtPath but resolves the struct fields in the path using fieldNameStrategy.
+
+The schema of a struct field is still looked up in DynamicSchemaNode.ChildNodes by the Go name of the field e.g., `$.first_name` with core.FieldNameJSONTag
+returns ChildNodes["FirstName"] for the field FirstName tagged with `json:"first_name"`.
+*/
+func GetSchemaAtPathWithFieldNameStrategy[T SchemaPath](path T, schema Schema, fieldNameStrategy *core.FieldNameStrategy) (*DynamicSchemaNode, error) {
 	const FunctionName = "GetSchemaAtPath"
 
 	var pathToSchema jsonpath.RecursiveDescentSegment
@@ -47,6 +59,7 @@ func GetSchemaAtPath[T SchemaPath](path T, schema Schema) (*DynamicSchemaNode, e
 
 	n := new(schemaAtPath)
 	n.RecursiveDescentSegment = pathToSchema
+	n.fieldNameStrategy = fieldNameStrategy
 	return n.recursiveGetSchemaAtPath(internal.PathSegmentsIndexes{CurrentCollection: 0, LastCollection: len(pathToSchema) - 1}, schema)
 }
 
@@ -99,6 +112,22 @@ func (n *schemaAtPath) recursiveGetDynamicSchemaAtPath(currentPathSegmentIndexes
 	return nil, lastSchemaNodeErr
 }
 
+// schemaNode returns currentSchema if it is a DynamicSchemaNode or its default node if it is a DynamicSchema, without applying the current path segment to it.
+func (n *schemaAtPath) schemaNode(currentPathSegmentIndexes internal.PathSegmentsIndexes, currentSchema Schema) (*DynamicSchemaNode, error) {
+	const FunctionName = "schemaNode"
+
+	switch s := currentSchema.(type) {
+	case *DynamicSchemaNode:
+		return s, nil
+	case *DynamicSchema:
+		return n.getDefaultDynamicSchemaNode(currentPathSegmentIndexes, s)
+	default:
+		return nil, NewError().WithFunctionName(FunctionName).WithMessage("unsupported schema type").
+			WithNestedError(ErrSchemaPathError).
+			WithData(core.JsonObject{"Schema": currentSchema, "PathSegments": n.RecursiveDescentSegment[:currentPathSegmentIndexes.CurrentCollection+1]})
+	}
+}
+
 func (n *schemaAtPath) getDefaultDynamicSchemaNode(currentPathSegmentIndexes internal.PathSegmentsIndexes, currentSchema *DynamicSchema) (*DynamicSchemaNode, error) {
 	const FunctionName = "getDefaultDynamicSchemaNode"
 
@@ -198,7 +227,7 @@ func (n *schemaAtPath) recursiveGetDynamicSchemaNodeAtPath(currentPathSegmentInd
 
 		if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 			newAssociativeCollectionEntryKeySchema := new(DynamicSchemaNode)
-			if value, err := n.recursiveGetSchemaAtPath(currentPathSegmentIndexes, currentSchema.ChildNodesAssociativeCollectionEntriesKeySchema); err != nil {
+			if value, err := n.schemaNode(currentPathSegmentIndexes, currentSchema.ChildNodesAssociativeCollectionEntriesKeySchema); err != nil {
 				return nil, NewError().WithFunctionName(FunctionName).WithMessage("default schema for all keys in associative entries not found").
 					WithNestedError(err).
 					WithData(core.JsonObject{"Schema": currentSchema, "PathSegments": n.RecursiveDescentSegment[:currentPathSegmentIndexes.CurrentCollection+1]})
@@ -207,7 +236,7 @@ func (n *schemaAtPath) recursiveGetDynamicSchemaNodeAtPath(currentPathSegmentInd
 			}
 
 			newAssociativeCollectionEntrySchema := new(DynamicSchemaNode)
-			if value, err := n.recursiveGetSchemaAtPath(currentPathSegmentIndexes, currentSchema.ChildNodesAssociativeCollectionEntriesValueSchema); err != nil {
+			if value, err := n.schemaNode(currentPathSegmentIndexes, currentSchema.ChildNodesAssociativeCollectionEntriesValueSchema); err != nil {
 				return nil, NewError().WithFunctionName(FunctionName).WithMessage("unsupported path type").
 					WithNestedError(err).
 					WithData(core.JsonObject{"Schema": currentSchema, "PathSegments": n.RecursiveDescentSegment[:currentPathSegmentIndexes.CurrentCollection+1]})
@@ -273,7 +302,19 @@ func (n *schemaAtPath) recursiveGetDynamicSchemaNodeAtPath(currentPathSegmentInd
 				WithData(core.JsonObject{"Schema": currentSchema, "PathSegments": n.RecursiveDescentSegment[:currentPathSegmentIndexes.CurrentCollection+1]})
 		}
 
-		if structFieldSchema, ok := currentSchema.ChildNodes[collectionKey]; ok {
+		structFieldSchema, ok := currentSchema.ChildNodes[collectionKey]
+		if currentSchema.Type != nil && currentSchema.Type.Kind() == reflect.Struct {
+			if n.fieldNameStrategy.IsAmbiguous(currentSchema.Type, collectionKey) {
+				return nil, NewError().WithFunctionName(FunctionName).WithMessage(fmt.Sprintf("struct field %s is ambiguous", collectionKey)).
+					WithNestedError(ErrSchemaPathError).
+					WithData(core.JsonObject{"Schema": currentSchema, "PathSegments": n.RecursiveDescentSegment[:currentPathSegmentIndexes.CurrentCollection+1]})
+			}
+			if structField, found := n.fieldNameStrategy.Field(currentSchema.Type, collectionKey); found {
+				structFieldSchema, ok = getStructFieldSchema(currentSchema, structField)
+			}
+		}
+
+		if ok {
 			if currentPathSegmentIndexes.CurrentCollection == currentPathSegmentIndexes.LastCollection {
 				switch sfs := structFieldSchema.(type) {
 				case *DynamicSchemaNode:
@@ -302,4 +343,49 @@ func (n *schemaAtPath) recursiveGetDynamicSchemaNodeAtPath(currentPathSegmentInd
 
 type schemaAtPath struct {
 	RecursiveDescentSegment jsonpath.RecursiveDescentSegment
+
+	// Resolves the names of struct fields in RecursiveDescentSegment.
+	fieldNameStrategy *core.FieldNameStrategy
+}
+
+/*
+getStructFieldSchema returns the schema of structField in structSchema.ChildNodes.
+
+The schema is looked up by the Go name of the field then by its name in a path. For a field promoted from an embedded struct, it is looked up
+in the schema of the embedded struct first e.g., ChildNodes["BaseEntity"].ChildNodes["ID"] for `ID` in `type Order struct { BaseEntity }`.
+*/
+func getStructFieldSchema(structSchema *DynamicSchemaNode, structField core.StructField) (Schema, bool) {
+	if len(structField.Index) > 1 && structSchema.Type != nil && structSchema.Type.Kind() == reflect.Struct {
+		embeddedSchema := structSchema
+		embeddedType := structSchema.Type
+		for _, index := range structField.Index[:len(structField.Index)-1] {
+			embeddedField := embeddedType.Field(index)
+			embeddedType = embeddedField.Type
+			if embeddedType.Kind() == reflect.Pointer {
+				embeddedType = embeddedType.Elem()
+			}
+
+			nextEmbeddedSchema, ok := embeddedSchema.ChildNodes[embeddedField.Name].(*DynamicSchemaNode)
+			if ok && nextEmbeddedSchema.Kind == reflect.Pointer {
+				nextEmbeddedSchema, ok = nextEmbeddedSchema.ChildNodesPointerSchema.(*DynamicSchemaNode)
+			}
+			if !ok {
+				embeddedSchema = nil
+				break
+			}
+			embeddedSchema = nextEmbeddedSchema
+		}
+
+		if embeddedSchema != nil {
+			if schema, ok := embeddedSchema.ChildNodes[structField.Field.Name]; ok {
+				return schema, true
+			}
+		}
+	}
+
+	if schema, ok := structSchema.ChildNodes[structField.Field.Name]; ok {
+		return schema, true
+	}
+	schema, ok := structSchema.ChildNodes[structField.Name]
+	return schema, ok
 }
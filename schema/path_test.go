@@ -220,4 +220,76 @@ func getSchemaAtPathDataTestData(yield func(data *getSchemaAtPathData) bool) {
 	) {
 		return
 	}
+
+	testCaseIndex++
+	if !yield(
+		&getSchemaAtPathData{
+			TestData: internal.TestData{
+				TestTitle: fmt.Sprintf("Test Case %d: Map entry that is a slice", testCaseIndex),
+			},
+			Schema: &DynamicSchemaNode{
+				Kind: reflect.Map,
+				Type: reflect.TypeOf(map[string][]int{}),
+				ChildNodesAssociativeCollectionEntriesKeySchema: &DynamicSchemaNode{
+					Kind: reflect.String,
+					Type: reflect.TypeOf(""),
+				},
+				ChildNodesAssociativeCollectionEntriesValueSchema: &DynamicSchemaNode{
+					Kind:                                     reflect.Slice,
+					Type:                                     reflect.TypeOf([]int{}),
+					ChildNodesLinearCollectionElementsSchema: &DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)},
+				},
+			},
+			Path:       "$.y",
+			ExpectedOk: true,
+			ExpectedData: &DynamicSchemaNode{
+				Kind:                                     reflect.Slice,
+				Type:                                     reflect.TypeOf([]int{}),
+				ChildNodesLinearCollectionElementsSchema: &DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)},
+				AssociativeCollectionEntryKeySchema: &DynamicSchemaNode{
+					Kind: reflect.String,
+					Type: reflect.TypeOf(""),
+				},
+			},
+		},
+	) {
+		return
+	}
+}
+
+func TestSchemaPath_GetSchemaAtPathWithFieldNameStrategy(t *testing.T) {
+	taggedItemSchema := TaggedItemSchema()
+
+	if res, err := GetSchemaAtPathWithFieldNameStrategy(path.JSONPath("$.item_name"), taggedItemSchema, core.FieldNameJSONTag); err != nil || res != taggedItemSchema.ChildNodes["ItemName"] {
+		t.Error("expected schema of ItemName, got=", res, "err=", err)
+	}
+
+	if res, err := GetSchemaAtPathWithFieldNameStrategy(path.JSONPath("$.ITEMNAME"), taggedItemSchema, core.FieldNameCaseInsensitive); err != nil || res != taggedItemSchema.ChildNodes["ItemName"] {
+		t.Error("expected schema of ItemName, got=", res, "err=", err)
+	}
+
+	if _, err := GetSchemaAtPath(path.JSONPath("$.item_name"), taggedItemSchema); err == nil {
+		t.Error("expected error when path uses json tag without a FieldNameStrategy")
+	}
+}
+
+func TestSchemaPath_GetSchemaAtPathEmbedded(t *testing.T) {
+	embeddedOrderSchema := EmbeddedOrderSchema()
+	embeddedAuditSchema := embeddedOrderSchema.ChildNodes["EmbeddedAudit"].(*DynamicSchemaNode).ChildNodesPointerSchema.(*DynamicSchemaNode)
+
+	if res, err := GetSchemaAtPath(path.JSONPath("$.ID"), embeddedOrderSchema); err != nil || res != embeddedOrderSchema.ChildNodes["EmbeddedBase"].(*DynamicSchemaNode).ChildNodes["ID"] {
+		t.Error("expected schema of promoted field ID, got=", res, "err=", err)
+	}
+
+	if res, err := GetSchemaAtPath(path.JSONPath("$.CreatedBy"), embeddedOrderSchema); err != nil || res != embeddedAuditSchema.ChildNodes["CreatedBy"] {
+		t.Error("expected schema of field CreatedBy promoted through pointer, got=", res, "err=", err)
+	}
+
+	if res, err := GetSchemaAtPath(path.JSONPath("$.EmbeddedBase.Version"), embeddedOrderSchema); err != nil || res == nil {
+		t.Error("expected schema of embedded struct field, got=", res, "err=", err)
+	}
+
+	if _, err := GetSchemaAtPath(path.JSONPath("$.Version"), embeddedOrderSchema); err == nil {
+		t.Error("expected error for ambiguous field Version")
+	}
 }
@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 	"reflect"
+	"slices"
 
 	"github.com/rogonion/go-json/core"
 	"github.com/rogonion/go-json/path"
@@ -35,17 +36,56 @@ func (n *Validation) validateDataWithDynamicSchemaNodeStruct(data reflect.Value,
 	}
 
 	childSchemaNodesValidated := make([]string, 0)
+
+	// Embedded structs with a schema are validated as a whole. Their promoted fields are validated at the same path as the fields of data.
+	embeddedStructsValidated := make([]int, 0)
+	structFields := n.fieldNameStrategy.Fields(data.Type())
 	for i := 0; i < data.NumField(); i++ {
-		structFieldName := data.Type().Field(i).Name
+		structField := data.Type().Field(i)
+		if !structField.Anonymous || !structField.IsExported() {
+			continue
+		}
+		if slices.ContainsFunc(structFields, func(field core.StructField) bool { return len(field.Index) == 1 && field.Index[0] == i }) {
+			// not promoted e.g., tagged with a name.
+			continue
+		}
 
-		childSchema, ok := schema.ChildNodes[structFieldName]
+		childSchema, ok := schema.ChildNodes[structField.Name]
 		if !ok {
 			continue
 		}
 
-		childSchemaNodesValidated = append(childSchemaNodesValidated, structFieldName)
+		childSchemaNodesValidated = append(childSchemaNodesValidated, structField.Name)
+		embeddedStructsValidated = append(embeddedStructsValidated, i)
 
-		if dataValidAgainstSchema, err := n.validateData(data.Field(i), childSchema, append(pathSegments, &path.CollectionMemberSegment{Key: structFieldName, IsKey: true})); !dataValidAgainstSchema {
+		if dataValidAgainstSchema, err := n.validateData(data.Field(i), childSchema, pathSegments); !dataValidAgainstSchema {
+			return false, err
+		}
+	}
+
+	for _, structField := range structFields {
+		if len(structField.Index) > 1 && slices.Contains(embeddedStructsValidated, structField.Index[0]) {
+			continue
+		}
+
+		childSchemaKey := structField.Field.Name
+		childSchema, ok := schema.ChildNodes[childSchemaKey]
+		if !ok {
+			childSchemaKey = structField.Name
+			if childSchema, ok = schema.ChildNodes[childSchemaKey]; !ok {
+				continue
+			}
+		}
+
+		fieldValue := structField.Value(data)
+		if !fieldValue.IsValid() {
+			// promoted through a nil pointer to an embedded struct.
+			continue
+		}
+
+		childSchemaNodesValidated = append(childSchemaNodesValidated, childSchemaKey)
+
+		if dataValidAgainstSchema, err := n.validateData(fieldValue, childSchema, append(pathSegments, &path.CollectionMemberSegment{Key: structField.Name, IsKey: true})); !dataValidAgainstSchema {
 			return false, err
 		}
 	}
@@ -410,6 +450,20 @@ func (n *Validation) SetValidateOnFirstMatch(value bool) {
 	n.validateOnFirstMatch = value
 }
 
+/*
+WithFieldNameStrategy sets the core.FieldNameStrategy used to name struct fields in the path segments of validation errors.
+
+ChildNodes of a struct schema are looked up by the Go name of a field first then by the name resolved by value.
+*/
+func (n *Validation) WithFieldNameStrategy(value *core.FieldNameStrategy) *Validation {
+	n.fieldNameStrategy = value
+	return n
+}
+
+func (n *Validation) SetFieldNameStrategy(value *core.FieldNameStrategy) {
+	n.fieldNameStrategy = value
+}
+
 func NewValidation() *Validation {
 	n := new(Validation)
 	n.validateOnFirstMatch = true
@@ -437,4 +491,5 @@ Example:
 type Validation struct {
 	validateOnFirstMatch bool
 	customValidators     Validators
+	fieldNameStrategy    *core.FieldNameStrategy
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/rogonion/go-json/core"
 	"github.com/rogonion/go-json/internal"
+	"github.com/rogonion/go-json/path"
 )
 
 func TestSchema_ValidateData(t *testing.T) {
@@ -471,3 +472,41 @@ func validationDataTestData(yield func(data *validationData) bool) {
 		return
 	}
 }
+
+func TestSchema_ValidateDataWithFieldNameStrategy(t *testing.T) {
+	taggedItemSchema := TaggedItemSchema()
+	taggedItemSchema.ChildNodes["ItemName"] = &DynamicSchemaNode{Kind: reflect.Int, Type: reflect.TypeOf(0)}
+
+	_, err := NewValidation().WithFieldNameStrategy(core.FieldNameJSONTag).ValidateData(TaggedItem{ItemID: 1, ItemName: "Widget"}, taggedItemSchema)
+	var validationError *core.Error
+	if !errors.As(err, &validationError) {
+		t.Fatal("expected validation to fail, got err=", err)
+	}
+	if pathSegments, ok := validationError.Data["PathSegments"].(path.RecursiveDescentSegment); !ok || pathSegments.String() != "$.item_name" {
+		t.Error("expected path segments to use json tag name, got=", validationError.Data["PathSegments"])
+	}
+}
+
+func TestSchema_ValidateDataEmbedded(t *testing.T) {
+	if ok, err := NewValidation().ValidateData(EmbeddedOrder{EmbeddedBase: EmbeddedBase{ID: 7}, Total: 9.5}, EmbeddedOrderSchema()); !ok {
+		t.Error("expected order with embedded struct schemas to be valid, err=", err)
+	}
+
+	// schema of promoted fields without a schema for the embedded structs.
+	flattenedSchema := &DynamicSchemaNode{
+		Kind: reflect.Struct,
+		Type: reflect.TypeOf(EmbeddedOrder{}),
+		ChildNodes: ChildNodes{
+			"ID":        &DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+			"CreatedBy": &DynamicSchemaNode{Kind: reflect.String, Type: reflect.TypeOf("")},
+		},
+	}
+	_, err := NewValidation().ValidateData(EmbeddedOrder{EmbeddedBase: EmbeddedBase{ID: 7}, EmbeddedAudit: &EmbeddedAudit{}}, flattenedSchema)
+	var validationError *core.Error
+	if !errors.As(err, &validationError) {
+		t.Fatal("expected validation of promoted field ID to fail, got err=", err)
+	}
+	if pathSegments, ok := validationError.Data["PathSegments"].(path.RecursiveDescentSegment); !ok || pathSegments.String() != "$.ID" {
+		t.Error("expected path segments of promoted field, got=", validationError.Data["PathSegments"])
+	}
+}